@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	oapitypes "github.com/oapi-codegen/runtime/types"
+	"github.com/shadream/kftm/metainfo"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// largest .torrent file kftm agrees to download
+const maxTorrentFileSize = 10 << 20
+
+// addTorrent adds a magnet link, a local .torrent file or an http(s) url of
+// a .torrent file to qbitorrent and fills the torrent hash of the job.
+func (p *processor) addTorrent(ctx context.Context, source string, job *Job) error {
+	if strings.HasPrefix(source, "magnet:") {
+		return p.addMagnet(ctx, source, job)
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		data, err = fetchTorrentFile(ctx, source)
+	} else {
+		data, err = os.ReadFile(source)
+	}
+	if err != nil {
+		return err
+	}
+
+	info, err := metainfo.Parse(data)
+	if err != nil {
+		return fmt.Errorf("parse torrent file: %w", err)
+	}
+
+	// files are known from the torrent itself, the movie file is chosen
+	// before adding and renaming does not wait for qbitorrent
+	file, err := p.pickTorrentFile(ctx, info)
+	if err != nil {
+		return err
+	}
+
+	var torrent oapitypes.File
+	torrent.InitFromBytes(data, torrentFileName(source, info))
+
+	addTorrentInfo := qbitorrent.AddTorrentsFiles{
+		AutoTMM:  makePointer(true),
+		Category: &job.Category,
+		Rename:   &job.Name,
+		Tags:     makePointer(strings.Join(jobTags(*job), ",")),
+		Torrents: &[]oapitypes.File{torrent},
+	}
+	// renaming expects files of multi-file torrents inside the torrent
+	// folder whatever content layout qbitorrent uses by default
+	if info.MultiFile {
+		addTorrentInfo.RootFolder = makePointer(qbitorrent.AddTorrentsFilesRootFolderTrue)
+	}
+
+	err = p.tClient.CreateTorrentFilesContext(ctx, addTorrentInfo)
+	err = checkAddError(err)
+	if err != nil {
+		return err
+	}
+
+	job.Hash = info.Hash()
+	job.FileIndex = makePointer(int64(file.Index))
+
+	return nil
+}
+
+func (p *processor) addMagnet(ctx context.Context, magnetLink string, job *Job) error {
+	magnet, err := metainfo.ParseMagnet(magnetLink)
+	if err != nil {
+		return err
+	}
+
+	err = p.tClient.CreateTorrentFileUrlContext(ctx, qbitorrent.AddTorrentsURLs{
+		AutoTMM:  makePointer(true),
+		Category: &job.Category,
+		Tags:     makePointer(strings.Join(jobTags(*job), ",")),
+		Urls:     &magnetLink,
+	})
+	err = checkAddError(err)
+	if err != nil {
+		return err
+	}
+
+	job.Hash = magnet.Hash()
+
+	return nil
+}
+
+// pickTorrentFile chooses the movie among video files of the torrent,
+// torrents without video are rejected.
+func (p *processor) pickTorrentFile(ctx context.Context, info *metainfo.MetaInfo) (metainfo.File, error) {
+	videos := Filter(info.Files, func(file metainfo.File) bool { return isVideo(file.Path) })
+	if len(videos) == 0 {
+		return metainfo.File{}, fmt.Errorf("torrent %s has no video files", info.Name)
+	}
+
+	if len(videos) == 1 {
+		return videos[0], nil
+	}
+
+	picked, err := p.pickFile(ctx, Select(videos, func(file metainfo.File) qbitorrent.TorrentsFiles {
+		return qbitorrent.TorrentsFiles{
+			Index: makePointer(int64(file.Index)),
+			Name:  makePointer(file.Path),
+			Size:  makePointer(file.Length),
+		}
+	}))
+	if err != nil {
+		return metainfo.File{}, err
+	}
+
+	return info.Files[*picked.Index], nil
+}
+
+// checkAddError explains errors of adding a torrent. Torrent that is already
+// in qbitorrent is not an error, kftm continues with it.
+func checkAddError(err error) error {
+	switch {
+	case errors.Is(err, qbitorrent.ErrConflict):
+		fmt.Println("torrent is already added, continuing")
+		return nil
+	case errors.Is(err, qbitorrent.ErrUnsupportedMediaType):
+		return fmt.Errorf("qbitorrent rejected the torrent: %w", err)
+	default:
+		return err
+	}
+}
+
+func fetchTorrentFile(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create torrent file request: %w", err)
+	}
+
+	resp, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download torrent file: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download torrent file %s: status code %d", url, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTorrentFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read torrent file: %w", err)
+	}
+
+	if len(data) > maxTorrentFileSize {
+		return nil, fmt.Errorf("torrent file %s is larger than %d bytes", url, maxTorrentFileSize)
+	}
+
+	return data, nil
+}
+
+func torrentFileName(source string, info *metainfo.MetaInfo) string {
+	name := filepath.Base(source)
+	if strings.HasSuffix(name, ".torrent") {
+		return name
+	}
+
+	return info.Hash() + ".torrent"
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// ensureCategories creates configured categories, library ones included,
+// missing in qbitorrent. Existing categories are left as they are, save
+// paths set in qbitorrent by hand win over the config. Library categories
+// must save to library.save_path, kftm waits for moved torrents there.
+func ensureCategories(ctx context.Context, tClient *qbitorrent.Client, config QbitorrentConfig) error {
+	existing, err := tClient.GetAllCategoriesContext(ctx)
+	if err != nil {
+		return fmt.Errorf("get categories: %w", err)
+	}
+
+	categories := config.Categories()
+	libraries := make(map[string]bool)
+	for _, category := range config.Categories() {
+		if category.Library != nil && category.Library.Category != "" {
+			categories = append(categories, CategoryConfig{
+				Category: category.Library.Category,
+				SavePath: category.Library.SavePath,
+			})
+			libraries[category.Library.Category] = true
+		}
+	}
+
+	for _, category := range categories {
+		if category.Category == "" {
+			continue
+		}
+
+		current, ok := existing[category.Category]
+		switch {
+		case !ok:
+			err = tClient.CreateCategoryContext(ctx, category.Category, category.SavePath)
+			if err != nil {
+				return fmt.Errorf("create category %s: %w", category.Category, err)
+			}
+
+			fmt.Printf("created category %s\n", category.Category)
+		case libraries[category.Category] && !samePath(flat(current.SavePath), category.SavePath):
+			return fmt.Errorf("library category %s saves to %s in qbitorrent, not to %s of config, change one of them",
+				category.Category, flat(current.SavePath), category.SavePath)
+		case category.SavePath != "" && !samePath(flat(current.SavePath), category.SavePath):
+			log.Printf("category %s saves to %s in qbitorrent, not to %s of config, it is left as is",
+				category.Category, flat(current.SavePath), category.SavePath)
+		}
+	}
+
+	return nil
+}
+
+// samePath compares paths of the qbitorrent host, which may use another
+// path separator than kftm.
+func samePath(a, b string) bool {
+	return strings.TrimRight(a, `/\`) == strings.TrimRight(b, `/\`)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/shadream/kftm/qbitorrent"
+	"github.com/shadream/kftm/qbitorrent/qbittest"
+)
+
+func TestEnsureCategoriesKeepsExistingPaths(t *testing.T) {
+	srv := qbittest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddCategory("movies", "/mnt/other/movies")
+
+	config := QbitorrentConfig{
+		Category: "movies",
+		SavePath: "/downloads/movies",
+		Series:   &CategoryConfig{Category: "series", SavePath: "/downloads/series"},
+		Library:  &LibraryConfig{Category: "library", SavePath: "/library"},
+	}
+
+	err := ensureCategories(context.Background(), qbitorrent.NewClient(srv.URL), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"movies":  "/mnt/other/movies",
+		"series":  "/downloads/series",
+		"library": "/library",
+	}
+	if got := srv.Categories(); !maps.Equal(got, want) {
+		t.Errorf("categories %v, want %v", got, want)
+	}
+	if requests := srv.RequestsTo("torrents/editCategory"); len(requests) != 0 {
+		t.Errorf("categories are edited: %+v", requests)
+	}
+}
+
+func TestEnsureCategoriesRefusesOtherLibraryPath(t *testing.T) {
+	srv := qbittest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddCategory("library", "/mnt/other/library")
+
+	config := QbitorrentConfig{
+		Category: "movies",
+		SavePath: "/downloads/movies",
+		Library:  &LibraryConfig{Category: "library", SavePath: "/library"},
+	}
+
+	// torrents would be moved where kftm does not wait for them
+	err := ensureCategories(context.Background(), qbitorrent.NewClient(srv.URL), config)
+	if err == nil {
+		t.Fatal("library category saving to another path is accepted")
+	}
+
+	if got := srv.Categories()["library"]; got != "/mnt/other/library" {
+		t.Errorf("library category is changed to %s", got)
+	}
+}
@@ -1,14 +1,228 @@
 package main
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRequestTimeout  = 30 * time.Second
+	defaultMetadataTimeout = 10 * time.Minute
+	defaultSearchTimeout   = time.Minute
+	defaultMoveTimeout     = time.Hour
+)
+
 type Config struct {
 	Qbitorrent     QbitorrentConfig `json:"qbitorrent"`
 	KinopoiskToken string           `json:"kinopoisk_token"`
+	// https://api.kinopoisk.dev by default
+	KinopoiskBaseUrl string `json:"kinopoisk_base_url"`
+	// Time limit for a single request to qbitorrent or kinopoisk, 30s by default
+	RequestTimeout Duration         `json:"request_timeout"`
+	Metadata       MetadataConfig   `json:"metadata"`
+	Files          FilesConfig      `json:"files"`
+	Search         SearchConfig     `json:"search"`
+	Quality        QualityConfig    `json:"quality"`
+	Rss            RssConfig        `json:"rss"`
+	Wanted         WantedConfig     `json:"wanted"`
+	OnComplete     OnCompleteConfig `json:"on_complete"`
+	// Share limits applied after processing, the first matching policy is
+	// used
+	Seeding []SeedingPolicy `json:"seeding"`
+}
+
+// MetadataConfig sets how long to wait for qbitorrent to fetch metadata of a
+// magnet link and what to do with the torrent if it never comes.
+type MetadataConfig struct {
+	// 10m by default
+	Timeout Duration `json:"timeout"`
+	// "keep" (default) tags the torrent as stalled and leaves it for the
+	// resume command, "remove" deletes it from qbitorrent
+	OnTimeout string `json:"on_timeout"`
 }
 
 type QbitorrentConfig struct {
-	BaseUrl      string `json:"base_url"`
-	Category     string `json:"category"`
+	BaseUrl  string `json:"base_url"`
+	Category string `json:"category"`
+	// Save path of the category as qbitorrent sees it. A missing category
+	// is created with it at startup, existing ones keep the path set in
+	// qbitorrent
+	SavePath string `json:"save_path"`
+	// The same folder as kftm sees it, nfo and posters are written there
 	RealSavePath string `json:"real_save_path"`
-	Username     string `json:"username"`
-	Password     string `json:"password"`
+	// Library the category is moved to after renaming, see LibraryConfig
+	Library *LibraryConfig `json:"library"`
+	// Categories for series and anime by kinopoisk type, everything else
+	// goes to the category above
+	Series   *CategoryConfig `json:"series"`
+	Anime    *CategoryConfig `json:"anime"`
+	Username string          `json:"username"`
+	Password string          `json:"password"`
+}
+
+// CategoryConfig is a qbitorrent category with its save paths, see
+// QbitorrentConfig for the meaning of fields.
+type CategoryConfig struct {
+	Category     string         `json:"category"`
+	SavePath     string         `json:"save_path"`
+	RealSavePath string         `json:"real_save_path"`
+	Library      *LibraryConfig `json:"library"`
+}
+
+// LibraryConfig turns the category into a staging one: torrents are
+// downloaded there and moved to the library after renaming, nfo and posters
+// are written in the library.
+type LibraryConfig struct {
+	// Category the torrent is switched to, qbitorrent moves it to the save
+	// path of the category. The torrent is moved with setLocation when empty
+	Category string `json:"category"`
+	// Library folder as qbitorrent sees it, the category is created with it
+	SavePath string `json:"save_path"`
+	// The same folder as kftm sees it
+	RealSavePath string `json:"real_save_path"`
+	// How long qbitorrent may move files to the library, 1h by default
+	MoveTimeout Duration `json:"move_timeout"`
+}
+
+// DefaultCategory returns the category for movies.
+func (c QbitorrentConfig) DefaultCategory() CategoryConfig {
+	return CategoryConfig{
+		Category:     c.Category,
+		SavePath:     c.SavePath,
+		RealSavePath: c.RealSavePath,
+		Library:      c.Library,
+	}
+}
+
+// Categories returns all configured categories, the default one first.
+func (c QbitorrentConfig) Categories() []CategoryConfig {
+	categories := []CategoryConfig{c.DefaultCategory()}
+	for _, category := range []*CategoryConfig{c.Series, c.Anime} {
+		if category != nil {
+			categories = append(categories, *category)
+		}
+	}
+
+	return categories
+}
+
+// CategoryForType chooses the category by kinopoisk type of the movie:
+// "tv-series" and "animated-series" are series, "anime" is anime.
+func (c QbitorrentConfig) CategoryForType(kinopoiskType string) CategoryConfig {
+	switch {
+	case kinopoiskType == "anime" && c.Anime != nil:
+		return *c.Anime
+	case strings.HasSuffix(kinopoiskType, "series") && c.Series != nil:
+		return *c.Series
+	default:
+		return c.DefaultCategory()
+	}
+}
+
+// CategoryByName returns the configured category with the name or the
+// default one. Library categories are not searched, jobs keep the staging
+// category.
+func (c QbitorrentConfig) CategoryByName(name string) CategoryConfig {
+	for _, category := range c.Categories() {
+		if category.Category == name {
+			return category
+		}
+	}
+
+	return c.DefaultCategory()
+}
+
+// FilesConfig decides which files of a torrent are downloaded besides the
+// movie itself.
+type FilesConfig struct {
+	// Set priority 0 for samples, other video files and everything that is
+	// not subtitles or external audio in wanted languages
+	SkipUnwanted bool `json:"skip_unwanted"`
+	// Words marking wanted subtitles and audio in file or folder names, e.g.
+	// ["rus", "ru", "eng"]. All subtitles and audio are kept when empty
+	Languages []string `json:"languages"`
+}
+
+// SearchConfig sets how the find command waits for search plugins, results
+// are ranked by the quality profile.
+type SearchConfig struct {
+	// Time limit for a single search, 1m by default
+	Timeout Duration `json:"timeout"`
+}
+
+// RssConfig sets feeds watched by rss rules of the watchlist command.
+type RssConfig struct {
+	// Feed urls as added in qbitorrent, all feeds when empty
+	Feeds []string `json:"feeds"`
+}
+
+// WantedConfig sets how often the schedule command looks for wanted movies.
+type WantedConfig struct {
+	// 6h by default
+	Interval Duration `json:"interval"`
+}
+
+// OnCompleteConfig sets up processing by "kftm on-complete" which qbitorrent
+// runs when a download finishes.
+type OnCompleteConfig struct {
+	// Torrents are only added by run, find and schedule, renaming and
+	// metadata are left to on-complete
+	Enabled bool `json:"enabled"`
+	// kftm.log next to config by default
+	LogFile string `json:"log_file"`
+}
+
+// validate rejects values the config can not have, so a typo is not taken
+// for the default.
+func (c *Config) validate() error {
+	switch c.Metadata.OnTimeout {
+	case "", onTimeoutKeep, onTimeoutRemove:
+	default:
+		return fmt.Errorf("metadata.on_timeout must be %q or %q, got %q", onTimeoutKeep, onTimeoutRemove, c.Metadata.OnTimeout)
+	}
+
+	// kftm waits for moved torrents in the library and writes metadata there
+	for _, category := range c.Qbitorrent.Categories() {
+		library := category.Library
+		if library != nil && (library.SavePath == "" || library.RealSavePath == "") {
+			return fmt.Errorf("library of category %q must have save_path and real_save_path", category.Category)
+		}
+	}
+
+	return nil
+}
+
+// Duration is time.Duration written in config as a string like "1m30s".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var str string
+	err := json.Unmarshal(data, &str)
+	if err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+
+	duration, err := time.ParseDuration(str)
+	if err != nil {
+		return fmt.Errorf("parse duration: %w", err)
+	}
+
+	*d = Duration(duration)
+
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Or returns d or fallback if d is not set.
+func (d Duration) Or(fallback time.Duration) time.Duration {
+	if d == 0 {
+		return fallback
+	}
+
+	return time.Duration(d)
 }
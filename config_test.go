@@ -0,0 +1,42 @@
+package main
+
+import "testing"
+
+func TestConfigValidateOnTimeout(t *testing.T) {
+	for _, value := range []string{"", onTimeoutKeep, onTimeoutRemove} {
+		config := Config{Metadata: MetadataConfig{OnTimeout: value}}
+		if err := config.validate(); err != nil {
+			t.Errorf("on_timeout %q: %v", value, err)
+		}
+	}
+
+	for _, value := range []string{"delete", "Remove", "keep "} {
+		config := Config{Metadata: MetadataConfig{OnTimeout: value}}
+		if err := config.validate(); err == nil {
+			t.Errorf("on_timeout %q is accepted", value)
+		}
+	}
+}
+
+func TestConfigValidateLibraryPaths(t *testing.T) {
+	for name, library := range map[string]LibraryConfig{
+		"no save_path":      {Category: "library", RealSavePath: "/mnt/library"},
+		"no real_save_path": {Category: "library", SavePath: "/library"},
+	} {
+		config := Config{Qbitorrent: QbitorrentConfig{
+			Category: "movies",
+			Series:   &CategoryConfig{Category: "series", Library: &library},
+		}}
+		if err := config.validate(); err == nil {
+			t.Errorf("library with %s is accepted", name)
+		}
+	}
+
+	config := Config{Qbitorrent: QbitorrentConfig{
+		Category: "movies",
+		Library:  &LibraryConfig{SavePath: "/library", RealSavePath: "/mnt/library"},
+	}}
+	if err := config.validate(); err != nil {
+		t.Errorf("library moved with setLocation: %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// errNoPlugins is returned when qbitorrent has no enabled search plugins.
+var errNoPlugins = errors.New("no enabled search plugins in qbitorrent, install them in search tab")
+
+const (
+	// how often results of a running search are read
+	searchPollInterval = 2 * time.Second
+	// how many ranked results are offered to choose from
+	searchShown = 10
+)
+
+// find searches torrents of the movie with search plugins of qbitorrent and
+// adds the chosen one like run does.
+func find(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("find", flag.ExitOnError)
+	profileName := flags.String("profile", "", "quality profile to rank results, the default one from config if empty")
+	flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		log.Fatal("usage: kftm find [-profile name] <kinopoisk url or id>")
+	}
+
+	filmId := parseKinopoiskUrl(flags.Arg(0))
+	if filmId == -1 {
+		log.Fatal("can not get kinopoisk film id")
+	}
+
+	p, err := newProcessor(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	p.profile, err = p.config.Quality.Profile(*profileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	movie, err := p.kClient.GetByIdContext(ctx, filmId)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	results, err := p.search(ctx, searchQueries(*movie))
+	if err != nil {
+		exitOnError(err)
+	}
+
+	ranked := rankResults(results, p.profile, movie.MovieLength)
+	if len(ranked) == 0 {
+		log.Fatalf("nothing suitable found for %s, %d results rejected by quality profile",
+			movieName(*movie), len(results))
+	}
+
+	result, err := pickResult(ctx, ranked[:min(len(ranked), searchShown)])
+	if err != nil {
+		exitOnError(err)
+	}
+
+	_, err = p.addAndProcess(ctx, movie, flat(result.FileUrl))
+	if err != nil {
+		exitOnError(err)
+	}
+
+	fmt.Println("all done!")
+}
+
+// movieTitles returns distinct titles of the movie: russian, original and
+// english ones.
+func movieTitles(movie kinopoisk.Movie) []string {
+	titles := []string{movie.Name, movie.AlternativeName}
+	// enName is null for most movies
+	if enName, ok := movie.EnName.(string); ok {
+		titles = append(titles, enName)
+	}
+
+	return distinctTitles(titles)
+}
+
+// movieNames returns titles of the movie together with its names in other
+// languages.
+func movieNames(movie kinopoisk.Movie) []string {
+	titles := movieTitles(movie)
+	for _, name := range movie.Names {
+		titles = append(titles, name.Name)
+	}
+
+	return distinctTitles(titles)
+}
+
+// distinctTitles drops titles without words, like "!!!", and titles
+// differing only in case.
+func distinctTitles(titles []string) []string {
+	var distinct []string
+	for _, title := range titles {
+		title = strings.TrimSpace(title)
+		if len(nameWords(title)) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(distinct, func(other string) bool { return strings.EqualFold(other, title) }) {
+			distinct = append(distinct, title)
+		}
+	}
+
+	return distinct
+}
+
+// searchQueries returns "title year" for every title of the movie.
+func searchQueries(movie kinopoisk.Movie) []string {
+	return Select(movieTitles(movie), func(title string) string {
+		if movie.Year == 0 {
+			return title
+		}
+		return fmt.Sprintf("%s %d", title, movie.Year)
+	})
+}
+
+// search runs queries one by one and returns their results without
+// duplicates.
+func (p *processor) search(ctx context.Context, queries []string) ([]qbitorrent.SearchResult, error) {
+	plugins, err := p.tClient.GetSearchPluginsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get search plugins: %w", err)
+	}
+
+	enabled := slices.ContainsFunc(plugins, func(plugin qbitorrent.SearchPlugin) bool {
+		return plugin.Enabled != nil && *plugin.Enabled
+	})
+	if !enabled {
+		return nil, errNoPlugins
+	}
+
+	timeout := p.config.Search.Timeout.Or(defaultSearchTimeout)
+
+	var results []qbitorrent.SearchResult
+	for _, query := range queries {
+		fmt.Printf("searching %q\n", query)
+
+		found, err := p.searchOne(ctx, query, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("search %q: %w", query, err)
+		}
+
+		for _, result := range found {
+			if result.FileUrl == nil {
+				continue
+			}
+
+			duplicate := slices.ContainsFunc(results, func(other qbitorrent.SearchResult) bool {
+				return *other.FileUrl == *result.FileUrl
+			})
+			if !duplicate {
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// searchOne collects results of a single search until plugins finish or the
+// timeout is reached. The search job is deleted afterwards.
+func (p *processor) searchOne(ctx context.Context, query string, timeout time.Duration) ([]qbitorrent.SearchResult, error) {
+	id, err := p.tClient.StartSearchContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		// ctx may be canceled already, the job still has to be deleted
+		err := p.tClient.DeleteSearch(id)
+		if err != nil {
+			log.Printf("delete search %d: %v", id, err)
+		}
+	}()
+
+	deadline := time.Now().Add(timeout)
+	var results []qbitorrent.SearchResult
+	for {
+		page, err := p.tClient.GetSearchResultsContext(ctx, id, len(results))
+		if err != nil {
+			return nil, err
+		}
+		if page.Results != nil {
+			results = append(results, *page.Results...)
+		}
+
+		if page.Status != nil && *page.Status == qbitorrent.SearchResultsStatusStopped {
+			return results, nil
+		}
+		if time.Now().After(deadline) {
+			fmt.Printf("search timed out, %d results\n", len(results))
+			return results, nil
+		}
+
+		err = sleep(ctx, searchPollInterval)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rankedResult is a search result with its score by the quality profile.
+type rankedResult struct {
+	qbitorrent.SearchResult
+	Score Score
+}
+
+// rankResults scores results of the search for a movie of the given length
+// in minutes, drops rejected ones and sorts the rest from the best.
+func rankResults(results []qbitorrent.SearchResult, profile QualityProfile, minutes int64) []rankedResult {
+	var ranked []rankedResult
+	for _, result := range results {
+		score := profile.Score(Release{
+			Name:    flat(result.FileName),
+			Size:    int64(flatFloat(result.FileSize)),
+			Seeders: int(flatFloat(result.NbSeeders)),
+		}, minutes)
+		if score.Rejected {
+			continue
+		}
+
+		ranked = append(ranked, rankedResult{SearchResult: result, Score: score})
+	}
+
+	slices.SortStableFunc(ranked, func(a, b rankedResult) int {
+		return compareScores(a.Score, b.Score)
+	})
+
+	return ranked
+}
+
+func pickResult(ctx context.Context, results []rankedResult) (rankedResult, error) {
+	fmt.Println("pick torrent:")
+	for index, item := range results {
+		fmt.Printf("%d) %s\t%s\t%d seeders\t%s\n   score %s\n", index+1, flat(item.FileName),
+			humanize.Bytes(uint64(flatFloat(item.FileSize))), int(flatFloat(item.NbSeeders)),
+			flat(item.SiteUrl), item.Score)
+	}
+
+	index, err := scanIndex(ctx, len(results))
+	if err != nil {
+		return rankedResult{}, err
+	}
+
+	return results[index], nil
+}
+
+func flatFloat(item *float32) float32 {
+	if item == nil {
+		return 0
+	}
+
+	return *item
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// JobStage is the last step of processing completed for a torrent.
+type JobStage string
+
+const (
+	StageAdded   JobStage = "added"
+	StageRenamed JobStage = "renamed"
+	// moved to the library in the two-stage layout
+	StageMoved    JobStage = "moved"
+	StageFinished JobStage = "finished"
+)
+
+// Job is a torrent processed by kftm. Jobs are saved after every stage, so
+// interrupted processing can be continued with the resume command.
+type Job struct {
+	Hash        string `json:"hash"`
+	KinopoiskID int    `json:"kinopoisk_id"`
+	Name        string `json:"name"`
+	// qbitorrent category, it tells where the movie folder is on disk
+	Category string `json:"category,omitempty"`
+	// Index of the movie file in the torrent when it is chosen before the
+	// torrent metadata is fetched by qbitorrent
+	FileIndex *int64    `json:"file_index,omitempty"`
+	Stage     JobStage  `json:"stage"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (j Job) Finished() bool {
+	return j.Stage == StageFinished
+}
+
+type JobStore struct {
+	path string
+	jobs map[string]Job
+}
+
+// OpenJobStore reads jobs from the file. Missing file is an empty store.
+func OpenJobStore(path string) (*JobStore, error) {
+	store := &JobStore{path: path}
+
+	err := store.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Reload reads jobs again, on-complete runs of other processes may have
+// saved theirs since the store was read. The file is replaced whole on
+// saving, so it is read without the lock.
+func (s *JobStore) Reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read jobs file: %w", err)
+	}
+
+	return s.load(data)
+}
+
+// load replaces jobs of the store by the ones in the file contents.
+func (s *JobStore) load(data []byte) error {
+	var jobs []Job
+	if data != nil {
+		err := json.Unmarshal(data, &jobs)
+		if err != nil {
+			return fmt.Errorf("unmarshal jobs: %w", err)
+		}
+	}
+
+	s.jobs = make(map[string]Job, len(jobs))
+	for _, job := range jobs {
+		s.jobs[job.Hash] = job
+	}
+
+	return nil
+}
+
+func (s *JobStore) Get(hash string) (Job, bool) {
+	job, ok := s.jobs[strings.ToLower(hash)]
+	return job, ok
+}
+
+// Unfinished returns jobs that have not passed all stages, oldest first.
+func (s *JobStore) Unfinished() []Job {
+	var jobs []Job
+	for _, job := range s.jobs {
+		if !job.Finished() {
+			jobs = append(jobs, job)
+		}
+	}
+
+	slices.SortFunc(jobs, func(a, b Job) int { return a.UpdatedAt.Compare(b.UpdatedAt) })
+
+	return jobs
+}
+
+// ByName returns a finished job of the movie folder name.
+func (s *JobStore) ByName(name string) (Job, bool) {
+	for _, job := range s.jobs {
+		if job.Finished() && job.Name == name {
+			return job, true
+		}
+	}
+
+	return Job{}, false
+}
+
+// Save adds or updates the job and writes the store to disk.
+func (s *JobStore) Save(job Job) error {
+	job.Hash = strings.ToLower(job.Hash)
+	job.UpdatedAt = time.Now()
+
+	return s.update(func() { s.jobs[job.Hash] = job })
+}
+
+// Delete removes the job and writes the store to disk.
+func (s *JobStore) Delete(hash string) error {
+	return s.update(func() { delete(s.jobs, strings.ToLower(hash)) })
+}
+
+// update applies the change to jobs read from disk, other processes may
+// have saved theirs since the store was opened.
+func (s *JobStore) update(change func()) error {
+	return updateStoreFile(s.path, func(data []byte) ([]byte, error) {
+		err := s.load(data)
+		if err != nil {
+			return nil, err
+		}
+
+		change()
+
+		jobs := slices.Collect(maps.Values(s.jobs))
+		slices.SortFunc(jobs, func(a, b Job) int { return strings.Compare(a.Hash, b.Hash) })
+
+		data, err = json.MarshalIndent(jobs, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal jobs: %w", err)
+		}
+
+		return data, nil
+	})
+}
+
+func jobStorePath() string {
+	return filepath.Join(filepath.Dir(configPath), "jobs.json")
+}
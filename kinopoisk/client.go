@@ -1,81 +1,147 @@
 package kinopoisk
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 )
 
 const (
-	baseAddress = "https://api.kinopoisk.dev"
-	apiVersion  = "v1.4"
+	DefaultBaseUrl = "https://api.kinopoisk.dev"
+	apiVersion     = "v1.4"
 )
 
-var ErrBadResponse = errors.New("bad response")
-
 type Client struct {
-	token  string
-	client *http.Client
+	// Address of kinopoisk.dev, DefaultBaseUrl unless changed, e.g. for a
+	// mirror or a fake server in tests
+	BaseUrl string
+	token   string
+	client  *http.Client
 }
 
 func NewClient(token string) *Client {
 	return &Client{
-		token:  token,
-		client: &http.Client{},
+		BaseUrl: DefaultBaseUrl,
+		token:   token,
+		client:  &http.Client{},
 	}
 }
 
-func (c *Client) get(endpoint string, opts map[string]string) (*http.Response, error) {
-	var err error
-	endpoint, err = url.JoinPath(baseAddress, apiVersion, endpoint)
+// SetTimeout limits time of every request made by the client. Zero means no
+// timeout.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.client.Timeout = timeout
+}
+
+// get requests the endpoint of the api with the query, keys may repeat, like
+// selectFields. Responses with other status than 200 are returned as
+// ResponseError.
+func (c *Client) get(ctx context.Context, endpoint string, query url.Values) (*http.Response, error) {
+	fullUrl, err := url.JoinPath(c.BaseUrl, apiVersion, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("bad request url: %w", err)
 	}
 
-	request, err := http.NewRequest(http.MethodGet, endpoint, nil)
-
-	var query url.Values
-	for key, value := range opts {
-		query.Add(key, value)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fullUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create get request: %w", err)
 	}
 
 	request.URL.RawQuery = query.Encode()
-
-	request.Header.Add("X-API-KEY", c.token)
+	request.Header.Set("X-API-KEY", c.token)
+	request.Header.Set("Accept", "application/json")
 
 	response, err := c.client.Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("do get request: %w", err)
 	}
 
+	if response.StatusCode != http.StatusOK {
+		return nil, newResponseError(response, endpoint)
+	}
+
 	return response, nil
 }
 
-func (c *Client) GetById(id int) (*Movie, error) {
-	endpoint := fmt.Sprintf("movie/%d", id)
-	response, err := c.get(endpoint, nil)
+// getJSON requests the endpoint and decodes the response into result.
+func (c *Client) getJSON(ctx context.Context, endpoint string, query url.Values, result any) error {
+	response, err := c.get(ctx, endpoint, query)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer closeBody(response.Body)
 
-	if response.StatusCode != http.StatusOK {
-		return nil, wrapWrongStatusCode(response.StatusCode)
+	err = json.NewDecoder(response.Body).Decode(result)
+	if err != nil {
+		return fmt.Errorf("decode %s response: %w", endpoint, err)
 	}
 
+	return nil
+}
+
+func (c *Client) GetById(id int) (*Movie, error) {
+	return c.GetByIdContext(context.Background(), id)
+}
+
+func (c *Client) GetByIdContext(ctx context.Context, id int) (*Movie, error) {
 	var result Movie
-	err = json.NewDecoder(response.Body).Decode(&result)
+	err := c.getJSON(ctx, fmt.Sprintf("movie/%d", id), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// MoviePage is a page of movies found by the movie and movie/search
+// endpoints.
+type MoviePage struct {
+	Docs []Movie `json:"docs"`
+	// Number of found movies on all pages
+	Total int `json:"total"`
+	Limit int `json:"limit"`
+	Page  int `json:"page"`
+	Pages int `json:"pages"`
+}
+
+// FindMovies returns the page of movies matching the filter, a nil filter
+// returns the first page of all movies.
+func (c *Client) FindMovies(filter *MovieFilter) (*MoviePage, error) {
+	return c.FindMoviesContext(context.Background(), filter)
+}
+
+func (c *Client) FindMoviesContext(ctx context.Context, filter *MovieFilter) (*MoviePage, error) {
+	var result MoviePage
+	err := c.getJSON(ctx, "movie", filter.Query(), &result)
 	if err != nil {
-		return nil, fmt.Errorf("decode movie dto: %w", err)
+		return nil, err
 	}
 
 	return &result, nil
 }
 
-func wrapWrongStatusCode(statusCode int) error {
-	return fmt.Errorf("wrong status code %d: %w", statusCode, ErrBadResponse)
+// SearchMovies finds movies by name, pages start with 1.
+func (c *Client) SearchMovies(query string, page, limit int) (*MoviePage, error) {
+	return c.SearchMoviesContext(context.Background(), query, page, limit)
+}
+
+func (c *Client) SearchMoviesContext(ctx context.Context, query string, page, limit int) (*MoviePage, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("page", strconv.Itoa(page))
+	values.Set("limit", strconv.Itoa(limit))
+
+	var result MoviePage
+	err := c.getJSON(ctx, "movie/search", values, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
 type Movie struct {
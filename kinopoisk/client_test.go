@@ -0,0 +1,311 @@
+package kinopoisk_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/kinopoisk/kinopoisktest"
+)
+
+func newClient(t *testing.T) (*kinopoisk.Client, *kinopoisktest.Server) {
+	t.Helper()
+
+	srv := kinopoisktest.NewServer()
+	t.Cleanup(srv.Close)
+
+	client := kinopoisk.NewClient(kinopoisktest.DefaultToken)
+	client.BaseUrl = srv.URL
+
+	return client, srv
+}
+
+func TestGetById(t *testing.T) {
+	client, srv := newClient(t)
+
+	movie, err := client.GetById(326)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if movie.ID != 326 || movie.Name != "Побег из Шоушенка" || movie.AlternativeName != "The Shawshank Redemption" {
+		t.Errorf("got movie %d %q (%q)", movie.ID, movie.Name, movie.AlternativeName)
+	}
+	if movie.Type != "movie" || movie.Year != 1994 || movie.MovieLength != 142 || movie.IsSeries {
+		t.Errorf("got %s of %d, %d minutes, series %v", movie.Type, movie.Year, movie.MovieLength, movie.IsSeries)
+	}
+	if want := time.Date(1994, 9, 10, 0, 0, 0, 0, time.UTC); !movie.Premiere.World.Equal(want) {
+		t.Errorf("world premiere %s, want %s", movie.Premiere.World, want)
+	}
+	if movie.ExternalID.Imdb != "tt0111161" || movie.ExternalID.Tmdb != 278 {
+		t.Errorf("external ids %+v", movie.ExternalID)
+	}
+	if movie.Rating.Kp != 9.111 || movie.Votes.Kp != 1218340 {
+		t.Errorf("rating %v with %v votes", movie.Rating.Kp, movie.Votes.Kp)
+	}
+	if len(movie.Persons) != 5 || movie.Persons[3].EnProfession != kinopoisk.Director || movie.Persons[3].Description != nil {
+		t.Errorf("persons %+v", movie.Persons)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 || requests[0].Endpoint != "movie/326" || requests[0].Token != kinopoisktest.DefaultToken {
+		t.Errorf("requests %+v", requests)
+	}
+}
+
+func TestGetByIdSeries(t *testing.T) {
+	client, _ := newClient(t)
+
+	series, err := client.GetById(464963)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !series.IsSeries || series.Type != "tv-series" || series.MovieLength != 0 {
+		t.Errorf("got %s, series %v, %d minutes", series.Type, series.IsSeries, series.MovieLength)
+	}
+	if series.Premiere.World.Year() != 2011 || !series.Premiere.Russia.IsZero() {
+		t.Errorf("premieres: world %s, russia %s", series.Premiere.World, series.Premiere.Russia)
+	}
+
+	// kinopoisk.dev sends null for unknown names and roles
+	enOnly, unnamed := series.Persons[1], series.Persons[2]
+	if enOnly.Name != nil || enOnly.EnName == nil || *enOnly.EnName != "Kit Harington" {
+		t.Errorf("person with english name only: %+v", enOnly)
+	}
+	if unnamed.Name != nil || unnamed.EnName != nil || unnamed.Description == nil {
+		t.Errorf("person without names: %+v", unnamed)
+	}
+}
+
+func TestGetByIdWithoutPremiere(t *testing.T) {
+	client, _ := newClient(t)
+
+	movie, err := client.GetById(5304486)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !movie.Premiere.World.IsZero() || movie.Year != 2027 {
+		t.Errorf("world premiere %s of %d, want none", movie.Premiere.World, movie.Year)
+	}
+	if movie.AlternativeName != "" || movie.Description != "" || movie.Poster.URL != "" {
+		t.Errorf("null fields are not empty: %q, %q, %q", movie.AlternativeName, movie.Description, movie.Poster.URL)
+	}
+}
+
+func TestGetByIdContext(t *testing.T) {
+	client, srv := newClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.GetByIdContext(ctx, 326)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+
+	// a request that can not be created is an error, not a panic
+	_, err = client.GetByIdContext(nil, 326)
+	if err == nil || !strings.Contains(err.Error(), "create get request") {
+		t.Errorf("got %v, want request creation error", err)
+	}
+
+	if requests := srv.Requests(); len(requests) != 0 {
+		t.Errorf("requests %+v are sent", requests)
+	}
+}
+
+func TestGetByIdErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		prepare func(srv *kinopoisktest.Server, client *kinopoisk.Client)
+		status  int
+		err     error
+		body    string
+	}{
+		{
+			name: "wrong token",
+			id:   326,
+			prepare: func(srv *kinopoisktest.Server, client *kinopoisk.Client) {
+				srv.Token = "another"
+			},
+			status: http.StatusUnauthorized,
+			err:    kinopoisk.ErrUnauthorized,
+			body:   "токен некорректен",
+		},
+		{
+			name: "spent quota",
+			id:   326,
+			prepare: func(srv *kinopoisktest.Server, client *kinopoisk.Client) {
+				srv.Quota = 1
+				client.GetById(326)
+			},
+			status: http.StatusForbidden,
+			err:    kinopoisk.ErrQuotaExceeded,
+			body:   "суточный лимит",
+		},
+		{
+			name: "rate limit",
+			id:   326,
+			prepare: func(srv *kinopoisktest.Server, client *kinopoisk.Client) {
+				srv.FailNext(http.StatusTooManyRequests)
+			},
+			status: http.StatusTooManyRequests,
+			err:    kinopoisk.ErrRateLimited,
+			body:   "Too Many Requests",
+		},
+		{
+			name:   "unknown movie",
+			id:     999999,
+			status: http.StatusNotFound,
+			err:    kinopoisk.ErrNotFound,
+			body:   "Фильм не найден",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, srv := newClient(t)
+			if test.prepare != nil {
+				test.prepare(srv, client)
+			}
+
+			_, err := client.GetById(test.id)
+			if !errors.Is(err, test.err) || !errors.Is(err, kinopoisk.ErrBadResponse) {
+				t.Fatalf("got %v, want %v", err, test.err)
+			}
+
+			var responseErr *kinopoisk.ResponseError
+			if !errors.As(err, &responseErr) {
+				t.Fatalf("%v is not ResponseError", err)
+			}
+			if responseErr.StatusCode != test.status || !strings.Contains(responseErr.Body, test.body) {
+				t.Errorf("got status %d, body %q", responseErr.StatusCode, responseErr.Body)
+			}
+		})
+	}
+}
+
+func movieIDs(page *kinopoisk.MoviePage) []int64 {
+	ids := []int64{}
+	for _, movie := range page.Docs {
+		ids = append(ids, movie.ID)
+	}
+
+	return ids
+}
+
+func TestFindMovies(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *kinopoisk.MovieFilter
+		want   []int64
+		total  int
+	}{
+		{name: "all", filter: nil, want: []int64{326, 464963, 5304486}, total: 3},
+		{name: "years", filter: kinopoisk.NewMovieFilter().Years(1990, 2015), want: []int64{326, 464963}, total: 2},
+		{name: "type", filter: kinopoisk.NewMovieFilter().Types(kinopoisk.TypeTvSeries), want: []int64{464963}, total: 1},
+		{name: "excluded type", filter: kinopoisk.NewMovieFilter().ExcludeTypes(kinopoisk.TypeTvSeries), want: []int64{326, 5304486}, total: 2},
+		{name: "any genre", filter: kinopoisk.NewMovieFilter().Genres("боевик", "фэнтези"), want: []int64{464963, 5304486}, total: 2},
+		{name: "all genres", filter: kinopoisk.NewMovieFilter().AllGenres("драма", "мелодрама"), want: []int64{464963}, total: 1},
+		{name: "excluded genre", filter: kinopoisk.NewMovieFilter().Genres("драма").ExcludeGenres("фэнтези"), want: []int64{326}, total: 1},
+		{name: "country", filter: kinopoisk.NewMovieFilter().Countries("США").ExcludeCountries("Великобритания"), want: []int64{326}, total: 1},
+		{name: "rating", filter: kinopoisk.NewMovieFilter().RatingKp(9.1, 10), want: []int64{326}, total: 1},
+		{name: "sorted", filter: kinopoisk.NewMovieFilter().SortBy("year", kinopoisk.Descending), want: []int64{5304486, 464963, 326}, total: 3},
+		{
+			name:   "sorted by two fields",
+			filter: kinopoisk.NewMovieFilter().SortBy("isSeries", kinopoisk.Ascending).SortBy("rating.imdb", kinopoisk.Descending),
+			want:   []int64{326, 5304486, 464963},
+			total:  3,
+		},
+		{name: "not null", filter: kinopoisk.NewMovieFilter().NotNull("movieLength", "alternativeName"), want: []int64{326}, total: 1},
+		{name: "page", filter: kinopoisk.NewMovieFilter().Limit(2).Page(2), want: []int64{5304486}, total: 3},
+		{name: "nothing", filter: kinopoisk.NewMovieFilter().Year(1895), want: []int64{}, total: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, _ := newClient(t)
+
+			page, err := client.FindMovies(test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := movieIDs(page); !slices.Equal(got, test.want) || page.Total != test.total {
+				t.Errorf("got %v of %d, want %v of %d", got, page.Total, test.want, test.total)
+			}
+		})
+	}
+}
+
+func TestFindMoviesSendsRepeatedKeys(t *testing.T) {
+	client, srv := newClient(t)
+
+	page, err := client.FindMovies(kinopoisk.NewMovieFilter().
+		Select("id", "name", "rating.kp").
+		NotNull("name").
+		Genres("драма", "фэнтези"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := srv.Requests()[0].Query
+	if !slices.Equal(query["selectFields"], []string{"id", "name", "rating.kp"}) ||
+		!slices.Equal(query["notNullFields"], []string{"name"}) ||
+		!slices.Equal(query["genres.name"], []string{"драма", "фэнтези"}) {
+		t.Errorf("sent query %v", query)
+	}
+
+	movie := page.Docs[0]
+	if movie.ID != 326 || movie.Name == "" || movie.Rating.Kp == 0 || movie.Year != 0 || movie.Persons != nil {
+		t.Errorf("not selected fields are returned: %+v", movie)
+	}
+}
+
+func TestFindMoviesUnknownField(t *testing.T) {
+	client, _ := newClient(t)
+
+	_, err := client.FindMovies(kinopoisk.NewMovieFilter().Set("budget.value", "1000-2000"))
+
+	var responseErr *kinopoisk.ResponseError
+	if !errors.As(err, &responseErr) || responseErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("got %v, want 400", err)
+	}
+	if !strings.Contains(responseErr.Body, "budget.value") {
+		t.Errorf("body %q does not name the field", responseErr.Body)
+	}
+}
+
+func TestSearchMovies(t *testing.T) {
+	client, srv := newClient(t)
+
+	page, err := client.SearchMovies("game of thrones", 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := movieIDs(page); !slices.Equal(got, []int64{464963}) || page.Pages != 1 {
+		t.Errorf("got %v of %d pages", got, page.Pages)
+	}
+
+	// every movie has "а" in its name
+	page, err = client.SearchMovies("а", 2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := movieIDs(page); !slices.Equal(got, []int64{5304486}) || page.Total != 3 || page.Pages != 2 {
+		t.Errorf("second page %v of %d movies on %d pages", got, page.Total, page.Pages)
+	}
+
+	query := srv.Requests()[1].Query
+	if query.Get("query") != "а" || query.Get("page") != "2" || query.Get("limit") != "2" {
+		t.Errorf("sent query %v", query)
+	}
+}
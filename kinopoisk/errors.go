@@ -0,0 +1,79 @@
+package kinopoisk
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maximum size of the response body kept in ResponseError
+const bodySnippetSize = 512
+
+var (
+	ErrBadResponse  = errors.New("bad response")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	// kinopoisk.dev answers 403 when daily request limit of the token is spent
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// ResponseError describes a response with unexpected status code. It matches
+// ErrBadResponse and one of the specific errors with errors.Is.
+type ResponseError struct {
+	StatusCode int
+	Endpoint   string
+	// Beginning of the response body, kinopoisk.dev puts error message there
+	Body string
+	Err  error
+}
+
+func (e *ResponseError) Error() string {
+	msg := fmt.Sprintf("%s: wrong status code %d", e.Endpoint, e.StatusCode)
+	if e.Body != "" {
+		msg += fmt.Sprintf(" (%s)", e.Body)
+	}
+
+	return fmt.Sprintf("%s: %v", msg, e.Err)
+}
+
+func (e *ResponseError) Unwrap() []error {
+	return []error{e.Err, ErrBadResponse}
+}
+
+// newResponseError reads error text from the response and closes its body.
+func newResponseError(resp *http.Response, endpoint string) error {
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, bodySnippetSize))
+	closeBody(resp.Body)
+
+	err := ErrBadResponse
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		err = ErrUnauthorized
+	case http.StatusForbidden:
+		err = ErrQuotaExceeded
+	case http.StatusNotFound:
+		err = ErrNotFound
+	case http.StatusConflict:
+		err = ErrConflict
+	case http.StatusTooManyRequests:
+		err = ErrRateLimited
+	}
+
+	return &ResponseError{
+		StatusCode: resp.StatusCode,
+		Endpoint:   endpoint,
+		Body:       strings.TrimSpace(string(snippet)),
+		Err:        err,
+	}
+}
+
+// closeBody reads the rest of the body, so the connection can be reused, and
+// closes it.
+func closeBody(body io.ReadCloser) {
+	io.Copy(io.Discard, body)
+	body.Close()
+}
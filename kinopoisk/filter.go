@@ -0,0 +1,217 @@
+package kinopoisk
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// MovieType is a value of the type field of movies.
+type MovieType string
+
+const (
+	TypeMovie          MovieType = "movie"
+	TypeTvSeries       MovieType = "tv-series"
+	TypeCartoon        MovieType = "cartoon"
+	TypeAnime          MovieType = "anime"
+	TypeAnimatedSeries MovieType = "animated-series"
+)
+
+// SortOrder is a direction of sorting by a field.
+type SortOrder int
+
+const (
+	Ascending  SortOrder = 1
+	Descending SortOrder = -1
+)
+
+// MovieFilter builds the query of the universal movie endpoint. Methods
+// return the filter, so calls chain:
+//
+//	kinopoisk.NewMovieFilter().
+//		Years(2000, 2010).
+//		Genres("драма").
+//		ExcludeGenres("ужасы").
+//		RatingKp(7, 10).
+//		SortBy("rating.kp", kinopoisk.Descending).
+//		Limit(50)
+//
+// Fields are named like in the api, e.g. "rating.kp" or "votes.imdb".
+type MovieFilter struct {
+	query url.Values
+}
+
+func NewMovieFilter() *MovieFilter {
+	return &MovieFilter{query: url.Values{}}
+}
+
+// Query returns a copy of the built query, empty for a nil filter.
+func (f *MovieFilter) Query() url.Values {
+	if f == nil {
+		return url.Values{}
+	}
+
+	query := make(url.Values, len(f.query))
+	for key, values := range f.query {
+		query[key] = append([]string(nil), values...)
+	}
+
+	return query
+}
+
+// Page sets the page of results, pages start with 1.
+func (f *MovieFilter) Page(page int) *MovieFilter {
+	f.query.Set("page", strconv.Itoa(page))
+	return f
+}
+
+// Limit sets how many movies a page has, the api allows up to 250.
+func (f *MovieFilter) Limit(limit int) *MovieFilter {
+	f.query.Set("limit", strconv.Itoa(limit))
+	return f
+}
+
+// Select limits fields of returned movies, others are left empty.
+func (f *MovieFilter) Select(fields ...string) *MovieFilter {
+	return f.add("selectFields", "", fields)
+}
+
+// NotNull skips movies without the fields.
+func (f *MovieFilter) NotNull(fields ...string) *MovieFilter {
+	return f.add("notNullFields", "", fields)
+}
+
+// SortBy sorts by the field, calls add more fields to sort by when values
+// of previous ones are equal.
+func (f *MovieFilter) SortBy(field string, order SortOrder) *MovieFilter {
+	f.query.Add("sortField", field)
+	f.query.Add("sortType", strconv.Itoa(int(order)))
+	return f
+}
+
+// IDs keeps movies with any of the kinopoisk ids.
+func (f *MovieFilter) IDs(ids ...int) *MovieFilter {
+	for _, id := range ids {
+		f.query.Add("id", strconv.Itoa(id))
+	}
+	return f
+}
+
+// Types keeps movies of any of the types.
+func (f *MovieFilter) Types(types ...MovieType) *MovieFilter {
+	for _, movieType := range types {
+		f.query.Add("type", string(movieType))
+	}
+	return f
+}
+
+// ExcludeTypes skips movies of the types.
+func (f *MovieFilter) ExcludeTypes(types ...MovieType) *MovieFilter {
+	for _, movieType := range types {
+		f.query.Add("type", "!"+string(movieType))
+	}
+	return f
+}
+
+// Series keeps only series or only not series.
+func (f *MovieFilter) Series(series bool) *MovieFilter {
+	f.query.Set("isSeries", strconv.FormatBool(series))
+	return f
+}
+
+// Year keeps movies of the year.
+func (f *MovieFilter) Year(year int) *MovieFilter {
+	f.query.Set("year", strconv.Itoa(year))
+	return f
+}
+
+// Years keeps movies from one year to another, both included.
+func (f *MovieFilter) Years(from, to int) *MovieFilter {
+	f.query.Set("year", intRange(from, to))
+	return f
+}
+
+// Genres keeps movies with any of the genres, e.g. "драма".
+func (f *MovieFilter) Genres(names ...string) *MovieFilter {
+	return f.add("genres.name", "", names)
+}
+
+// AllGenres keeps movies with every of the genres.
+func (f *MovieFilter) AllGenres(names ...string) *MovieFilter {
+	return f.add("genres.name", "+", names)
+}
+
+// ExcludeGenres skips movies with any of the genres.
+func (f *MovieFilter) ExcludeGenres(names ...string) *MovieFilter {
+	return f.add("genres.name", "!", names)
+}
+
+// Countries keeps movies of any of the countries, e.g. "США".
+func (f *MovieFilter) Countries(names ...string) *MovieFilter {
+	return f.add("countries.name", "", names)
+}
+
+// AllCountries keeps movies made by every of the countries together.
+func (f *MovieFilter) AllCountries(names ...string) *MovieFilter {
+	return f.add("countries.name", "+", names)
+}
+
+// ExcludeCountries skips movies of any of the countries.
+func (f *MovieFilter) ExcludeCountries(names ...string) *MovieFilter {
+	return f.add("countries.name", "!", names)
+}
+
+// RatingKp keeps movies rated on kinopoisk from one value to another.
+func (f *MovieFilter) RatingKp(from, to float64) *MovieFilter {
+	f.query.Set("rating.kp", floatRange(from, to))
+	return f
+}
+
+// RatingImdb keeps movies rated on imdb from one value to another.
+func (f *MovieFilter) RatingImdb(from, to float64) *MovieFilter {
+	f.query.Set("rating.imdb", floatRange(from, to))
+	return f
+}
+
+// VotesKp keeps movies with a number of kinopoisk votes in the range.
+func (f *MovieFilter) VotesKp(from, to int) *MovieFilter {
+	f.query.Set("votes.kp", intRange(from, to))
+	return f
+}
+
+// Length keeps movies lasting from one number of minutes to another.
+func (f *MovieFilter) Length(from, to int) *MovieFilter {
+	f.query.Set("movieLength", intRange(from, to))
+	return f
+}
+
+// Set sets a field the filter has no method for, the value is passed as is,
+// like "7-10" or "!null".
+func (f *MovieFilter) Set(field, value string) *MovieFilter {
+	f.query.Set(field, value)
+	return f
+}
+
+func (f *MovieFilter) add(key, prefix string, values []string) *MovieFilter {
+	for _, value := range values {
+		f.query.Add(key, prefix+value)
+	}
+	return f
+}
+
+// intRange formats the range as the api expects, "from-to" or a single
+// value when they are equal.
+func intRange(from, to int) string {
+	if from == to {
+		return strconv.Itoa(from)
+	}
+
+	return strconv.Itoa(from) + "-" + strconv.Itoa(to)
+}
+
+func floatRange(from, to float64) string {
+	if from == to {
+		return strconv.FormatFloat(from, 'f', -1, 64)
+	}
+
+	return strconv.FormatFloat(from, 'f', -1, 64) + "-" + strconv.FormatFloat(to, 'f', -1, 64)
+}
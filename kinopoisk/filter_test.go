@@ -0,0 +1,60 @@
+package kinopoisk_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/shadream/kftm/kinopoisk"
+)
+
+func TestMovieFilterQuery(t *testing.T) {
+	filter := kinopoisk.NewMovieFilter().
+		Types(kinopoisk.TypeMovie, kinopoisk.TypeCartoon).
+		Years(2000, 2010).
+		Genres("драма", "комедия").
+		AllGenres("криминал").
+		ExcludeGenres("ужасы").
+		ExcludeCountries("Индия").
+		RatingKp(7.5, 10).
+		RatingImdb(8, 8).
+		Select("id", "name").
+		NotNull("poster.url").
+		SortBy("rating.kp", kinopoisk.Descending).
+		SortBy("year", kinopoisk.Ascending).
+		Page(2).
+		Limit(50)
+
+	want := url.Values{
+		"type":           {"movie", "cartoon"},
+		"year":           {"2000-2010"},
+		"genres.name":    {"драма", "комедия", "+криминал", "!ужасы"},
+		"countries.name": {"!Индия"},
+		"rating.kp":      {"7.5-10"},
+		"rating.imdb":    {"8"},
+		"selectFields":   {"id", "name"},
+		"notNullFields":  {"poster.url"},
+		"sortField":      {"rating.kp", "year"},
+		"sortType":       {"-1", "1"},
+		"page":           {"2"},
+		"limit":          {"50"},
+	}
+
+	query := filter.Query()
+	if !reflect.DeepEqual(query, want) {
+		t.Errorf("got %v\nwant %v", query, want)
+	}
+
+	// the query is a copy
+	query.Add("year", "1990")
+	if len(filter.Query()["year"]) != 1 {
+		t.Errorf("filter changed with its query: %v", filter.Query())
+	}
+}
+
+func TestNilMovieFilterQuery(t *testing.T) {
+	var filter *kinopoisk.MovieFilter
+	if query := filter.Query(); query == nil || len(query) != 0 {
+		t.Errorf("got %v, want empty query", query)
+	}
+}
@@ -0,0 +1,523 @@
+// Package kinopoisktest runs a fake kinopoisk.dev API in process for tests.
+// It serves movies recorded from the real API, finds them by name and by
+// filters of the universal movie endpoint, checks the token and can script
+// rate limits and spent quotas.
+package kinopoisktest
+
+import (
+	"cmp"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultToken is the token the server accepts unless Token is changed.
+const DefaultToken = "TEST-TOKEN"
+
+// Recorded responses: movie_<id>.json are movies served by the server,
+// error_<status>.json are error bodies.
+//
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// Fixture returns a recorded response by file name, e.g. "movie_326.json".
+func Fixture(name string) []byte {
+	data, err := fixtures.ReadFile(path.Join("fixtures", name))
+	if err != nil {
+		panic(fmt.Sprintf("kinopoisktest: no fixture %s", name))
+	}
+
+	return data
+}
+
+// MovieIDs returns ids of recorded movies in ascending order.
+func MovieIDs() []int {
+	names, _ := fs.Glob(fixtures, "fixtures/movie_*.json")
+
+	var ids []int
+	for _, name := range names {
+		id, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path.Base(name), "movie_"), ".json"))
+		if err == nil {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+// Server is a fake kinopoisk.dev with the recorded movies. Fields are read on
+// every request, set them before the client is used.
+type Server struct {
+	*httptest.Server
+
+	// Token expected in X-API-KEY header
+	Token string
+	// Requests the token may make before the server answers 403, 0 is no
+	// limit
+	Quota int
+
+	mu       sync.Mutex
+	movies   map[int][]byte
+	failures []int
+	requests []Request
+	used     int
+}
+
+// Request is a request received by the server.
+type Request struct {
+	// Path relative to the api version, like "movie/326"
+	Endpoint string
+	Query    url.Values
+	Token    string
+}
+
+// NewServer starts a server with the recorded movies, it must be closed
+// with Close.
+func NewServer() *Server {
+	s := &Server{
+		Token:  DefaultToken,
+		movies: map[int][]byte{},
+	}
+	for _, id := range MovieIDs() {
+		s.movies[id] = Fixture(fmt.Sprintf("movie_%d.json", id))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1.4/movie", s.find)
+	mux.HandleFunc("GET /v1.4/movie/search", s.search)
+	mux.HandleFunc("GET /v1.4/movie/{id}", s.movie)
+	s.Server = httptest.NewServer(s.checked(mux))
+
+	return s
+}
+
+// AddMovie serves the movie json by its id, replacing a recorded one.
+func (s *Server) AddMovie(data []byte) error {
+	var movie struct {
+		ID int `json:"id"`
+	}
+	err := json.Unmarshal(data, &movie)
+	if err != nil {
+		return fmt.Errorf("decode movie: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.movies[movie.ID] = data
+
+	return nil
+}
+
+// FailNext makes the next request fail with the status, the recorded error
+// body is sent when there is one. Calls are queued.
+func (s *Server) FailNext(status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, status)
+}
+
+// Requests returns requests received so far, the failed ones included.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// checked records the request and answers scripted failures, wrong tokens
+// and spent quota before the handler.
+func (s *Server) checked(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+
+		token := r.Header.Get("X-API-KEY")
+		s.requests = append(s.requests, Request{
+			Endpoint: strings.TrimPrefix(r.URL.Path, "/v1.4/"),
+			Query:    r.URL.Query(),
+			Token:    token,
+		})
+
+		status := 0
+		switch {
+		case len(s.failures) != 0:
+			status = s.failures[0]
+			s.failures = s.failures[1:]
+		case token != s.Token:
+			status = http.StatusUnauthorized
+		case s.Quota > 0 && s.used >= s.Quota:
+			status = http.StatusForbidden
+		default:
+			s.used++
+		}
+
+		s.mu.Unlock()
+
+		if status != 0 {
+			writeError(w, status)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) movie(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{
+			"statusCode": http.StatusBadRequest,
+			"message":    []string{"Значение поля id должно быть в диапазоне от 250 до 7000000!"},
+			"error":      "Bad Request",
+		})
+		return
+	}
+
+	s.mu.Lock()
+	data, ok := s.movies[id]
+	s.mu.Unlock()
+
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(data)
+}
+
+// search finds movies with the query in any of their names, like the
+// movie/search endpoint. Results are ordered by id.
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	query := strings.ToLower(r.URL.Query().Get("query"))
+	page := positive(r.URL.Query().Get("page"), 1)
+	limit := positive(r.URL.Query().Get("limit"), 10)
+
+	s.mu.Lock()
+	ids := slices.Sorted(maps.Keys(s.movies))
+	var found []json.RawMessage
+	for _, id := range ids {
+		if matchesQuery(s.movies[id], query) {
+			found = append(found, s.movies[id])
+		}
+	}
+	s.mu.Unlock()
+
+	docs := []json.RawMessage{}
+	if start := (page - 1) * limit; start < len(found) {
+		docs = found[start:min(start+limit, len(found))]
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"docs":  docs,
+		"total": len(found),
+		"limit": limit,
+		"page":  page,
+		"pages": (len(found) + limit - 1) / limit,
+	})
+}
+
+// find filters movies like the universal movie endpoint: numeric fields
+// take "from-to" ranges, list fields take plain values for any of them,
+// "+value" for required and "!value" for excluded ones. Unknown parameters
+// are rejected with 400 as the api does.
+func (s *Server) find(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	for key := range query {
+		if !slices.Contains(findParams, key) && !slices.Contains(rangeFields, key) {
+			writeJSON(w, http.StatusBadRequest, map[string]any{
+				"statusCode": http.StatusBadRequest,
+				"message":    []string{fmt.Sprintf("Поле %s не поддерживается", key)},
+				"error":      "Bad Request",
+			})
+			return
+		}
+	}
+
+	page := positive(query.Get("page"), 1)
+	limit := positive(query.Get("limit"), 10)
+
+	s.mu.Lock()
+	var found []map[string]any
+	for _, id := range slices.Sorted(maps.Keys(s.movies)) {
+		var movie map[string]any
+		if json.Unmarshal(s.movies[id], &movie) == nil && matchesFilter(movie, query) {
+			found = append(found, movie)
+		}
+	}
+	s.mu.Unlock()
+
+	sortMovies(found, query["sortField"], query["sortType"])
+
+	docs := []map[string]any{}
+	if start := (page - 1) * limit; start < len(found) {
+		docs = found[start:min(start+limit, len(found))]
+	}
+	if fields := query["selectFields"]; len(fields) != 0 {
+		for i, movie := range docs {
+			docs[i] = selectFields(movie, fields)
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"docs":  docs,
+		"total": len(found),
+		"limit": limit,
+		"page":  page,
+		"pages": (len(found) + limit - 1) / limit,
+	})
+}
+
+var (
+	findParams = []string{
+		"page", "limit", "selectFields", "notNullFields", "sortField", "sortType",
+		"id", "type", "isSeries", "genres.name", "countries.name",
+	}
+	rangeFields = []string{
+		"year", "rating.kp", "rating.imdb", "votes.kp", "votes.imdb", "movieLength", "ageRating",
+	}
+)
+
+func matchesFilter(movie map[string]any, query url.Values) bool {
+	for _, field := range query["notNullFields"] {
+		if lookup(movie, field) == nil {
+			return false
+		}
+	}
+
+	for _, field := range rangeFields {
+		if value := query.Get(field); value != "" && !inRange(lookup(movie, field), value) {
+			return false
+		}
+	}
+
+	if value := query.Get("isSeries"); value != "" && fmt.Sprint(movie["isSeries"]) != value {
+		return false
+	}
+
+	id := strconv.FormatFloat(number(movie["id"]), 'f', -1, 64)
+	typ, _ := movie["type"].(string)
+
+	return matchesValues([]string{id}, query["id"]) &&
+		matchesValues([]string{typ}, query["type"]) &&
+		matchesValues(names(movie["genres"]), query["genres.name"]) &&
+		matchesValues(names(movie["countries"]), query["countries.name"])
+}
+
+// matchesValues checks values of the movie against filter values: one of
+// plain values, every "+value" and none of "!value" must be there.
+func matchesValues(have, filter []string) bool {
+	anyOf := false
+	matched := false
+	for _, value := range filter {
+		switch {
+		case strings.HasPrefix(value, "+"):
+			if !slices.Contains(have, value[1:]) {
+				return false
+			}
+		case strings.HasPrefix(value, "!"):
+			if slices.Contains(have, value[1:]) {
+				return false
+			}
+		default:
+			anyOf = true
+			matched = matched || slices.Contains(have, value)
+		}
+	}
+
+	return !anyOf || matched
+}
+
+// inRange checks a number against "value" or "from-to".
+func inRange(value any, filter string) bool {
+	if value == nil {
+		return false
+	}
+
+	from, to, ok := strings.Cut(filter, "-")
+	if !ok {
+		to = from
+	}
+	low, errLow := strconv.ParseFloat(from, 64)
+	high, errHigh := strconv.ParseFloat(to, 64)
+	if errLow != nil || errHigh != nil {
+		return false
+	}
+
+	return number(value) >= low && number(value) <= high
+}
+
+// sortMovies sorts by the fields in order, movies stay ordered by id when
+// values are equal.
+func sortMovies(movies []map[string]any, fields, types []string) {
+	slices.SortStableFunc(movies, func(a, b map[string]any) int {
+		for i, field := range fields {
+			order := 1
+			if i < len(types) && types[i] == "-1" {
+				order = -1
+			}
+
+			if c := compareValues(lookup(a, field), lookup(b, field)); c != 0 {
+				return c * order
+			}
+		}
+		return 0
+	})
+}
+
+func compareValues(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	switch x := a.(type) {
+	case string:
+		y, _ := b.(string)
+		return strings.Compare(x, y)
+	case bool:
+		y, _ := b.(bool)
+		return cmp.Compare(boolNumber(x), boolNumber(y))
+	}
+
+	return cmp.Compare(number(a), number(b))
+}
+
+func boolNumber(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// selectFields keeps top level fields of the movie named by fields, like
+// "rating" of "rating.kp".
+func selectFields(movie map[string]any, fields []string) map[string]any {
+	selected := map[string]any{}
+	for _, field := range fields {
+		key, _, _ := strings.Cut(field, ".")
+		if value, ok := movie[key]; ok {
+			selected[key] = value
+		}
+	}
+
+	return selected
+}
+
+// lookup returns the value by the dotted path, like "rating.kp".
+func lookup(movie map[string]any, field string) any {
+	var value any = movie
+	for _, key := range strings.Split(field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+
+	return value
+}
+
+// names returns names of the list of objects, like genres.
+func names(value any) []string {
+	list, _ := value.([]any)
+
+	var names []string
+	for _, item := range list {
+		if object, ok := item.(map[string]any); ok {
+			if name, ok := object["name"].(string); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+func number(value any) float64 {
+	n, _ := value.(float64)
+	return n
+}
+
+func matchesQuery(data []byte, query string) bool {
+	var movie struct {
+		Name            *string `json:"name"`
+		AlternativeName *string `json:"alternativeName"`
+		EnName          *string `json:"enName"`
+		Names           []struct {
+			Name string `json:"name"`
+		} `json:"names"`
+	}
+	if json.Unmarshal(data, &movie) != nil {
+		return false
+	}
+
+	names := []string{}
+	for _, name := range []*string{movie.Name, movie.AlternativeName, movie.EnName} {
+		if name != nil {
+			names = append(names, *name)
+		}
+	}
+	for _, name := range movie.Names {
+		names = append(names, name.Name)
+	}
+
+	return slices.ContainsFunc(names, func(name string) bool {
+		return strings.Contains(strings.ToLower(name), query)
+	})
+}
+
+// writeError sends the recorded body of the status or a generic one.
+func writeError(w http.ResponseWriter, status int) {
+	data, err := fixtures.ReadFile(fmt.Sprintf("fixtures/error_%d.json", status))
+	if err != nil {
+		writeJSON(w, status, map[string]any{
+			"statusCode": status,
+			"message":    http.StatusText(status),
+			"error":      http.StatusText(status),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// positive parses a positive number or returns fallback.
+func positive(value string, fallback int) int {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return fallback
+	}
+
+	return number
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// moveToLibrary moves the torrent of the job from the staging category to the
+// library, by switching the category or with setLocation, and waits until
+// qbitorrent finishes moving files.
+func (p *processor) moveToLibrary(ctx context.Context, job Job, library LibraryConfig) error {
+	fmt.Println("moving to library...")
+
+	var err error
+	if library.Category != "" {
+		// qbitorrent moves files of torrents in automatic mode when the
+		// category changes
+		err = p.tClient.SetAutoManagementContext(ctx, qbitorrent.TorrentsSetAutoManagementPostFormdataBody{
+			Hashes: job.Hash,
+			Enable: makePointer(true),
+		})
+		if err != nil {
+			return fmt.Errorf("enable automatic management: %w", err)
+		}
+
+		err = p.tClient.SetCategoryContext(ctx, qbitorrent.TorrentsSetCategoryPostFormdataBody{
+			Hashes:   job.Hash,
+			Category: &library.Category,
+		})
+		if err != nil {
+			return fmt.Errorf("set category: %w", err)
+		}
+	} else {
+		err = p.tClient.SetLocationContext(ctx, qbitorrent.TorrentsSetLocationPostFormdataBody{
+			Hashes:   job.Hash,
+			Location: &library.SavePath,
+		})
+		if err != nil {
+			return fmt.Errorf("set location: %w", err)
+		}
+	}
+
+	return p.waitMoved(ctx, job.Hash, library.SavePath, library.MoveTimeout.Or(defaultMoveTimeout))
+}
+
+// waitMoved polls qbitorrent until the torrent is in savePath and not moving.
+// It fails after the timeout, the job is left for resume.
+func (p *processor) waitMoved(ctx context.Context, hash, savePath string, timeout time.Duration) error {
+	start := time.Now()
+	defer fmt.Println()
+
+	for {
+		torrent, err := p.tClient.GetTorrentContext(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		moving := torrent.State != nil && *torrent.State == qbitorrent.TorrentInfoStateMoving
+		if !moving && samePath(flat(torrent.SavePath), savePath) {
+			return nil
+		}
+
+		if time.Since(start) > timeout {
+			return fmt.Errorf("torrent is not moved to %s in %s", savePath, timeout)
+		}
+
+		printStatus("moving to %s, %s", savePath, time.Since(start).Round(time.Second))
+
+		err = sleep(ctx, time.Second)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// realSavePath returns the folder with the movie folder of the job as kftm
+// sees it, the library one once the job is moved there.
+func (p *processor) realSavePath(job Job) string {
+	category := p.config.Qbitorrent.CategoryByName(job.Category)
+	if category.Library != nil && (job.Stage == StageMoved || job.Stage == StageFinished) {
+		return category.Library.RealSavePath
+	}
+
+	return category.RealSavePath
+}
@@ -1,25 +1,31 @@
 package main
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"flag"
 	"fmt"
 	"image"
 	"image/jpeg"
+	"io"
 	"log"
 	"net/http"
 	"os"
-	"path"
+	"os/signal"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/dustin/go-humanize"
-	"github.com/go-bittorrent/magneturi"
 	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/metainfo"
 	"github.com/shadream/kftm/qbitorrent"
 )
 
@@ -35,15 +41,41 @@ func init() {
 	defaultConfigPath := filepath.Join(filepath.Dir(exePath), "config.json")
 	flag.StringVar(&configPath, "config", defaultConfigPath, "path to config")
 	flag.BoolVar(&changeVar, "change", false, "change already created torrent")
-
-	flag.Parse()
 }
 
 func main() {
-	if changeVar {
-		change()
-	} else {
-		run()
+	// parsed here and not in init, so test binaries of the package can
+	// register their -test flags first
+	flag.Parse()
+
+	// Ctrl+C cancels requests in flight, jobs stay in the store and can be
+	// continued by resume command
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch flag.Arg(0) {
+	case "refresh":
+		refresh(ctx, flag.Args()[1:])
+	case "resume":
+		resume(ctx)
+	case "find":
+		find(ctx, flag.Args()[1:])
+	case "watchlist":
+		watchlist(ctx, flag.Args()[1:])
+	case "want":
+		want(ctx, flag.Args()[1:])
+	case "schedule":
+		schedule(ctx, flag.Args()[1:])
+	case "on-complete":
+		onComplete(ctx, flag.Args()[1:])
+	case "cleanup":
+		cleanup(ctx, flag.Args()[1:])
+	default:
+		if changeVar {
+			change(ctx)
+		} else {
+			run(ctx)
+		}
 	}
 }
 
@@ -59,12 +91,26 @@ func readConfig() (*Config, error) {
 		return nil, fmt.Errorf("unmarshall json config: %w", err)
 	}
 
+	err = config.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
 	return &config, nil
 }
 
-func createQbitorrentClient(torrentConfig QbitorrentConfig) (*qbitorrent.Client, error) {
+func createQbitorrentClient(ctx context.Context, config *Config) (*qbitorrent.Client, error) {
+	torrentConfig := config.Qbitorrent
 	client := qbitorrent.NewClient(torrentConfig.BaseUrl)
-	err := client.Login(torrentConfig.Username, torrentConfig.Password)
+	client.SetTimeout(config.RequestTimeout.Or(defaultRequestTimeout))
+
+	// qbitorrent may be set up to bypass authentication for localhost or
+	// whitelisted subnets, then username is left empty in config
+	if torrentConfig.Username == "" {
+		return client, nil
+	}
+
+	err := client.LoginContext(ctx, torrentConfig.Username, torrentConfig.Password)
 	if err != nil {
 		return nil, fmt.Errorf("login to qbitorrent: %w", err)
 	}
@@ -72,270 +118,336 @@ func createQbitorrentClient(torrentConfig QbitorrentConfig) (*qbitorrent.Client,
 	return client, nil
 }
 
-func change() {
-	config, err := readConfig()
-	if err != nil {
-		log.Fatal(err)
+func createKinopoiskClient(config *Config) *kinopoisk.Client {
+	client := kinopoisk.NewClient(config.KinopoiskToken)
+	if config.KinopoiskBaseUrl != "" {
+		client.BaseUrl = config.KinopoiskBaseUrl
 	}
+	client.SetTimeout(config.RequestTimeout.Or(defaultRequestTimeout))
+
+	return client
+}
 
-	tClient, err := createQbitorrentClient(config.Qbitorrent)
+func change(ctx context.Context) {
+	p, err := newProcessor(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	fmt.Println("paste torrent hash:")
-	var hash string
-	_, err = fmt.Scanln(&hash)
+	fmt.Println("paste torrent hash or magnet link:")
+	var input string
+	err = scanln(ctx, &input)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	kClient := kinopoisk.NewClient(config.KinopoiskToken)
-
-	fmt.Print("paste kinopois url or id: ")
-	var kinopoiskUrl string
-	_, err = fmt.Scanln(&kinopoiskUrl)
+	hash, err := metainfo.ParseHash(input)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	filmId := parseKinopoiskUrl(kinopoiskUrl)
-	if filmId == -1 {
-		log.Fatal("can not get kinopoisk film id")
+	// a torrent handled by kftm before has the kinopoisk id tag, remove
+	// the tag in qbitorrent to choose another movie
+	filmId := -1
+	torrent, err := p.tClient.GetTorrentContext(ctx, hash)
+	switch {
+	case err == nil:
+		filmId = kinopoiskIdFromTags(qbitorrent.TagList(*torrent))
+	case !errors.Is(err, qbitorrent.ErrNotFound):
+		log.Fatal(err)
 	}
 
-	fmt.Printf("kinopoisk film id: %d\n", filmId)
-
-	movie, err := kClient.GetById(filmId)
+	var movie *kinopoisk.Movie
+	if filmId != -1 {
+		fmt.Printf("kinopoisk film id from tag: %d\n", filmId)
+		movie, err = p.kClient.GetByIdContext(ctx, filmId)
+	} else {
+		movie, err = askMovie(ctx, p.kClient)
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	nfo := KinopoiskDtoToNfo(*movie)
-
-	name := whitelistString(fmt.Sprintf("%s (%d)", movie.Name, movie.Premiere.World.Year()))
-
-	fmt.Println("getting files...")
-
-	for {
-		content, err := tClient.GetTorrentContent(hash)
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if len(content) == 0 {
-			time.Sleep(time.Second)
-			continue
-		}
-
-		file := content[0]
-		if len(content) != 1 {
-			file = PickFile(content)
-		}
-
-		fileExt := path.Ext(*file.Name)
-		err = tClient.RenameFile(qbitorrent.RenameTorrentFiles{
-			Hash:    hash,
-			OldPath: *file.Name,
-			NewPath: fmt.Sprintf("%s/%s%s", name, name, fileExt),
-		})
-		if err != nil {
-			log.Fatal(err)
-		}
-
-		if len(content) != 1 {
-			dir := path.Dir(*file.Name)
-			err = tClient.RenameFolder(qbitorrent.RenameTorrentFiles{
-				Hash:    hash,
-				OldPath: dir,
-				NewPath: name,
-			})
-			if err != nil {
-				log.Fatal(err)
-			}
-
-		}
-
-		break
+	job := Job{
+		Hash:        hash,
+		KinopoiskID: int(movie.ID),
+		Name:        movieName(*movie),
+		Category:    p.config.Qbitorrent.CategoryForType(movie.Type).Category,
+		Stage:       StageAdded,
 	}
 
-	movieDir := filepath.Join(config.Qbitorrent.RealSavePath, name)
-
-	for {
-		_, err := os.ReadDir(movieDir)
-		if err != nil {
-			time.Sleep(2 * time.Second)
-			continue
-		}
-
-		break
+	// the torrent keeps the category it was added with
+	if torrent != nil {
+		job.Category = flat(torrent.Category)
 	}
 
-	nfoPath := filepath.Join(config.Qbitorrent.RealSavePath, name, fmt.Sprintf("%s.nfo", name))
-	nfoData, err := xml.Marshal(nfo)
+	err = p.jobs.Save(job)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	nfoData = []byte(xml.Header + string(nfoData))
-
-	err = os.WriteFile(nfoPath, nfoData, 0o755)
+	err = p.process(ctx, job, movie)
 	if err != nil {
-		log.Fatal(err)
+		exitOnError(err)
 	}
 
-	imagePath := filepath.Join(config.Qbitorrent.RealSavePath, name, "poster.jpg")
-
-	downloadImage(movie.Poster.URL, imagePath)
-
 	fmt.Println("all done!")
 }
 
-func run() {
-	config, err := readConfig()
+func run(ctx context.Context) {
+	p, err := newProcessor(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	tClient, err := createQbitorrentClient(config.Qbitorrent)
+	movie, err := askMovie(ctx, p.kClient)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	kClient := kinopoisk.NewClient(config.KinopoiskToken)
-
-	fmt.Printf("paste kinopois url or id: ")
-	var kinopoiskUrl string
-	_, err = fmt.Scanln(&kinopoiskUrl)
+	fmt.Println("paste magnet link, path or url of .torrent file:")
+	source, err := scanLine(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	filmId := parseKinopoiskUrl(kinopoiskUrl)
-	if filmId == -1 {
-		log.Fatal("can not get kinopoisk film id")
+	_, err = p.addAndProcess(ctx, movie, source)
+	if err != nil {
+		exitOnError(err)
 	}
 
-	fmt.Printf("kinopoisk film id: %d\n", filmId)
+	fmt.Println("all done!")
+}
 
-	movie, err := kClient.GetById(filmId)
-	if err != nil {
-		log.Fatal(err)
+// addAndProcess adds the torrent from source for the movie and runs all
+// stages of the new job, unless processing is left to on-complete. The job is
+// returned with the hash filled even when processing fails.
+func (p *processor) addAndProcess(ctx context.Context, movie *kinopoisk.Movie, source string) (Job, error) {
+	job := Job{
+		KinopoiskID: int(movie.ID),
+		Name:        movieName(*movie),
+		Category:    p.config.Qbitorrent.CategoryForType(movie.Type).Category,
+		Stage:       StageAdded,
 	}
 
-	nfo := KinopoiskDtoToNfo(*movie)
-
-	fmt.Println("paste magnet link:")
-	var magnetLink string
-	_, err = fmt.Scanln(&magnetLink)
+	err := p.addTorrent(ctx, source, &job)
 	if err != nil {
-		log.Fatal(err)
+		return job, err
 	}
 
-	magnetParsed, err := magneturi.Parse(magnetLink)
+	err = p.jobs.Save(job)
 	if err != nil {
-		log.Fatal(err)
+		return job, err
 	}
 
-	hash, _ := strings.CutPrefix(magnetParsed.ExactTopics[0], "urn:btih:")
+	if p.config.OnComplete.Enabled {
+		fmt.Println("the torrent is processed when the download finishes")
+		return job, nil
+	}
 
-	err = tClient.CreateTorrentFileUrl(qbitorrent.AddTorrentsURLs{
-		AutoTMM:  makePointer(true),
-		Category: &config.Qbitorrent.Category,
-		Urls:     &magnetLink,
-	})
+	return job, p.process(ctx, job, movie)
+}
+
+// resume continues processing of jobs interrupted earlier and processes
+// torrents added by rss rules of the watchlist.
+func resume(ctx context.Context) {
+	p, err := newProcessor(ctx)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	name := whitelistString(fmt.Sprintf("%s (%d)", movie.Name, movie.Premiere.World.Year()))
+	err = p.adoptTagged(ctx)
+	if err != nil {
+		exitOnError(err)
+	}
 
-	fmt.Println("getting files...")
+	jobs := p.jobs.Unfinished()
+	if len(jobs) == 0 {
+		fmt.Println("nothing to resume")
+		return
+	}
 
-	for {
-		content, err := tClient.GetTorrentContent(hash)
+	for _, job := range jobs {
+		job, ok, err := p.currentJob(job.Hash)
 		if err != nil {
-			log.Fatal(err)
+			exitOnError(err)
 		}
-
-		if len(content) == 0 {
-			time.Sleep(time.Second)
+		if !ok || job.Finished() {
 			continue
 		}
 
-		file := PickFile(content)
-		fileExt := path.Ext(*file.Name)
-		err = tClient.RenameFile(qbitorrent.RenameTorrentFiles{
-			Hash:    hash,
-			OldPath: *file.Name,
-			NewPath: fmt.Sprintf("%s/%s%s", name, name, fileExt),
-		})
+		fmt.Printf("resuming %s (%s), stage: %s\n", job.Name, job.Hash, job.Stage)
+
+		err = p.process(ctx, job, nil)
+		if errors.Is(err, ErrMetadataTimeout) {
+			log.Print(err)
+			continue
+		}
 		if err != nil {
-			log.Fatal(err)
+			exitOnError(err)
 		}
+	}
 
-		if len(content) != 1 {
-			dir := path.Dir(*file.Name)
-			err = tClient.RenameFolder(qbitorrent.RenameTorrentFiles{
-				Hash:    hash,
-				OldPath: dir,
-				NewPath: name,
-			})
-			if err != nil {
-				log.Fatal(err)
-			}
+	fmt.Println("all done!")
+}
 
-		}
+// askMovie asks user for kinopoisk url or id and loads the movie.
+func askMovie(ctx context.Context, kClient *kinopoisk.Client) (*kinopoisk.Movie, error) {
+	fmt.Print("paste kinopois url or id: ")
+	var kinopoiskUrl string
+	err := scanln(ctx, &kinopoiskUrl)
+	if err != nil {
+		return nil, err
+	}
 
-		break
+	filmId := parseKinopoiskUrl(kinopoiskUrl)
+	if filmId == -1 {
+		return nil, errors.New("can not get kinopoisk film id")
 	}
 
-	nfoPath := filepath.Join(config.Qbitorrent.RealSavePath, name, fmt.Sprintf("%s.nfo", name))
-	nfoData, err := xml.Marshal(nfo)
-	if err != nil {
-		log.Fatal(err)
+	fmt.Printf("kinopoisk film id: %d\n", filmId)
+
+	return kClient.GetByIdContext(ctx, filmId)
+}
+
+// exitOnError stops kftm. Interruption is reported separately since the job
+// is saved and can be continued.
+func exitOnError(err error) {
+	if errors.Is(err, context.Canceled) {
+		log.Fatal("interrupted, run \"kftm resume\" to continue")
 	}
 
-	for {
-		err = os.WriteFile(nfoPath, nfoData, 0o755)
-		if err != nil {
-			log.Println(err)
-			time.Sleep(time.Second)
-			continue
+	log.Fatal(err)
+}
+
+// scanln is fmt.Scanln that gives up when ctx is done.
+func scanln(ctx context.Context, a ...any) error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := fmt.Scanln(a...)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// scanLine reads a whole line from stdin, unlike scanln it keeps spaces, so
+// it is used for paths. Quotes added by "copy as path" are removed.
+func scanLine(ctx context.Context) (string, error) {
+	done := make(chan error, 1)
+	var line []byte
+	go func() {
+		// read byte by byte as fmt.Scanln does, so nothing is buffered away
+		// from the following prompts
+		buf := make([]byte, 1)
+		for {
+			_, err := os.Stdin.Read(buf)
+			if err != nil {
+				done <- err
+				return
+			}
+			if buf[0] == '\n' {
+				done <- nil
+				return
+			}
+			line = append(line, buf[0])
 		}
+	}()
 
-		break
+	select {
+	case err := <-done:
+		if err != nil && (!errors.Is(err, io.EOF) || len(line) == 0) {
+			return "", err
+		}
+		return strings.Trim(strings.TrimSpace(string(line)), `"`), nil
+	case <-ctx.Done():
+		return "", ctx.Err()
 	}
+}
 
-	imagePath := filepath.Join(config.Qbitorrent.RealSavePath, name, "poster.jpg")
+// sleep pauses for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
 
-	downloadImage(movie.Poster.URL, imagePath)
+// movieName returns "Name (year)" by the world premiere, or by the
+// production year for movies without one.
+func movieName(movie kinopoisk.Movie) string {
+	year := int64(movie.Premiere.World.Year())
+	if movie.Premiere.World.IsZero() {
+		year = movie.Year
+	}
 
-	fmt.Println("all done!")
+	return whitelistString(fmt.Sprintf("%s (%d)", movie.Name, year))
 }
 
-func PickFile(files []qbitorrent.TorrentsFiles) qbitorrent.TorrentsFiles {
-	var index int
+// pickFile asks the user to pick the movie file, files are listed from the
+// best by the quality profile with their scores. Without the user the best
+// one is taken.
+func (p *processor) pickFile(ctx context.Context, files []qbitorrent.TorrentsFiles) (qbitorrent.TorrentsFiles, error) {
+	scores := make(map[string]Score, len(files))
+	for _, item := range files {
+		release := Release{Name: withoutExtension(*item.Name), Size: *item.Size, Seeders: -1}
+		scores[*item.Name] = p.profile.Score(release, 0)
+	}
+
+	files = slices.Clone(files)
+	slices.SortStableFunc(files, func(a, b qbitorrent.TorrentsFiles) int {
+		// the largest file is the movie more likely than samples and extras
+		return cmp.Or(compareScores(scores[*a.Name], scores[*b.Name]), cmp.Compare(*b.Size, *a.Size))
+	})
+
+	if p.auto {
+		fmt.Printf("picked movie file %s, score %s\n", *files[0].Name, scores[*files[0].Name])
+		return files[0], nil
+	}
+
 	fmt.Println("pick movie file:")
 	for index, item := range files {
-		fmt.Printf("%d) %s\t%s\n", index+1, *item.Name, humanize.Bytes(uint64(*item.Size)))
+		fmt.Printf("%d) %s\t%s\tscore %s\n", index+1, *item.Name, humanize.Bytes(uint64(*item.Size)), scores[*item.Name])
 	}
 
+	index, err := scanIndex(ctx, len(files))
+	if err != nil {
+		return qbitorrent.TorrentsFiles{}, err
+	}
+
+	return files[index], nil
+}
+
+// scanIndex reads 1-based index of one of count listed items until the input
+// is valid and returns it 0-based.
+func scanIndex(ctx context.Context, count int) (int, error) {
+	var index int
 	for {
-		_, err := fmt.Scanln(&index)
+		err := scanln(ctx, &index)
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
 		if err != nil {
 			fmt.Println("wrong input, write index:")
 			continue
 		}
-		if index < 1 || index > len(files) {
+		if index < 1 || index > count {
 			fmt.Println("index is too small or too big. write index:")
 			continue
 		}
 
-		break
+		return index - 1, nil
 	}
-
-	return files[index-1]
 }
 
 var kinopoiskUrlRegex = regexp.MustCompile(`\.kinopoisk\.ru/film/(\d+)`)
@@ -364,19 +476,42 @@ func KinopoiskDtoToNfo(dto kinopoisk.Movie) MovieNfo {
 		return item.EnProfession == "director"
 	})
 
+	// kinopoisk sends null names of persons it knows little about, actors
+	// without any name are useless for media centers
 	actors := Filter(dto.Persons, func(item kinopoisk.Person) bool {
-		return item.EnProfession == "actor"
+		return item.EnProfession == "actor" && personName(item) != ""
 	})
 
 	actorDto := Select(actors, func(item kinopoisk.Person) Actor {
 		return Actor{
-			Name:  flat(item.Name),
+			Name:  personName(item),
 			Role:  flat(item.Description),
 			Thumb: item.Photo,
 		}
 	})
 
+	uniqueIDs := []UniqueID{
+		{Type: "kinopoisk", Default: "true", Value: strconv.FormatInt(dto.ID, 10)},
+	}
+	if dto.ExternalID.Imdb != "" {
+		uniqueIDs = append(uniqueIDs, UniqueID{Type: "imdb", Value: dto.ExternalID.Imdb})
+	}
+	if dto.ExternalID.Tmdb != 0 {
+		uniqueIDs = append(uniqueIDs, UniqueID{Type: "tmdb", Value: strconv.FormatInt(dto.ExternalID.Tmdb, 10)})
+	}
+
+	var directors []string
+	if name := personName(director); name != "" {
+		directors = append(directors, name)
+	}
+
+	var premiered string
+	if !dto.Premiere.World.IsZero() {
+		premiered = dto.Premiere.World.Format("2006-01-02")
+	}
+
 	return MovieNfo{
+		XMLName:       xml.Name{Local: nfoRootMovie},
 		Title:         dto.Name,
 		Originaltitle: dto.AlternativeName,
 		Ratings: Ratings{
@@ -397,15 +532,16 @@ func KinopoiskDtoToNfo(dto kinopoisk.Movie) MovieNfo {
 				},
 			},
 		},
-		Outline: dto.ShortDescription,
-		Plot:    dto.Description,
-		Tagline: dto.Slogan,
-		Genre: strings.Join(Select(dto.Genres,
-			func(item kinopoisk.Country) string { return item.Name }), ", "),
+		Outline:   dto.ShortDescription,
+		Plot:      dto.Description,
+		Tagline:   dto.Slogan,
+		Genre:     Select(dto.Genres, func(item kinopoisk.Country) string { return item.Name }),
 		Country:   Select(dto.Countries, func(item kinopoisk.Country) string { return item.Name }),
-		Director:  *director.Name,
+		Director:  directors,
 		Actor:     actorDto,
-		Premiered: dto.Premiere.World.Format("2006-01-02"),
+		Premiered: premiered,
+		Year:      strconv.FormatInt(dto.Year, 10),
+		Uniqueid:  uniqueIDs,
 		// Thumb: []Thumb{
 		// 	{
 		// 		Aspect:  "poster",
@@ -416,6 +552,11 @@ func KinopoiskDtoToNfo(dto kinopoisk.Movie) MovieNfo {
 	}
 }
 
+// personName returns the russian name of the person or the english one.
+func personName(person kinopoisk.Person) string {
+	return cmp.Or(flat(person.Name), flat(person.EnName))
+}
+
 func TakeOne[T any](slice []T, selector func(T) bool) (T, bool) {
 	var item T
 	for _, item := range slice {
@@ -459,32 +600,42 @@ func flat(item *string) string {
 	return *item
 }
 
-func downloadImage(url string, pathToSave string) {
-	r, err := http.NewRequest(http.MethodGet, url, nil)
+// client for images and .torrent files hosted outside of apis
+var downloadClient = &http.Client{Timeout: 5 * time.Minute}
+
+func downloadImage(ctx context.Context, url string, pathToSave string) error {
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("create image request: %w", err)
 	}
 
-	resp, err := http.DefaultClient.Do(r)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		log.Fatal(err)
+	resp, err := downloadClient.Do(r)
+	if err != nil {
+		return fmt.Errorf("download image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download image %s: status code %d", url, resp.StatusCode)
 	}
 
 	img, _, err := image.Decode(resp.Body)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("decode image: %w", err)
 	}
 
 	file, err := os.Create(pathToSave)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("create image file: %w", err)
 	}
 	defer file.Close()
 
 	err = jpeg.Encode(file, img, nil)
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("encode image: %w", err)
 	}
+
+	return nil
 }
 
 var whitelistRegex = regexp.MustCompile(`[^a-zA-Z\(\)0-9а-я-А-Я\ ]`)
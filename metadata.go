@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+const (
+	tagStalled = "kftm:stalled"
+
+	onTimeoutKeep   = "keep"
+	onTimeoutRemove = "remove"
+)
+
+var ErrMetadataTimeout = errors.New("torrent metadata was not received in time")
+
+// waitMetadata polls qbitorrent until the torrent file list is known. While
+// waiting it shows the torrent state and peers, so a dead magnet is visible.
+// On timeout the torrent is tagged as stalled or removed, as configured.
+func (p *processor) waitMetadata(ctx context.Context, hash string) ([]qbitorrent.TorrentsFiles, error) {
+	timeout := p.config.Metadata.Timeout.Or(defaultMetadataTimeout)
+	start := time.Now()
+
+	// status line is rewritten in place, it is finished with new line on exit
+	defer fmt.Println()
+
+	for {
+		content, err := p.tClient.GetTorrentContentContext(ctx, hash)
+		// torrent added a moment ago may be unknown to qbitorrent yet
+		if err != nil && !errors.Is(err, qbitorrent.ErrNotFound) {
+			return nil, err
+		}
+
+		if len(content) != 0 {
+			return content, nil
+		}
+
+		torrent, err := p.tClient.GetTorrentContext(ctx, hash)
+		switch {
+		case errors.Is(err, qbitorrent.ErrNotFound):
+			printStatus("waiting for torrent to appear, %s", time.Since(start).Round(time.Second))
+		case err != nil:
+			return nil, err
+		default:
+			printStatus("%s, %s", torrentStatus(*torrent), time.Since(start).Round(time.Second))
+		}
+
+		if time.Since(start) > timeout {
+			return nil, p.handleStalled(ctx, hash)
+		}
+
+		err = sleep(ctx, time.Second)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// handleStalled applies on_timeout policy to a torrent without metadata.
+func (p *processor) handleStalled(ctx context.Context, hash string) error {
+	if p.config.Metadata.OnTimeout == onTimeoutRemove {
+		err := p.tClient.DeleteTorrentsContext(ctx, []string{hash}, true)
+		if err != nil {
+			return fmt.Errorf("remove stalled torrent: %w", err)
+		}
+
+		err = p.jobs.Delete(hash)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("torrent %s is removed: %w", hash, ErrMetadataTimeout)
+	}
+
+	err := p.tClient.AddTagsContext(ctx, []string{hash}, []string{tagStalled})
+	if err != nil {
+		return fmt.Errorf("tag stalled torrent: %w", err)
+	}
+
+	return fmt.Errorf("torrent %s is tagged %s, continue later with resume: %w", hash, tagStalled, ErrMetadataTimeout)
+}
+
+// torrentStatus describes state of the torrent and its swarm, e.g.
+// "metaDL, seeds 0 (0), peers 2 (5)". Numbers in parentheses are swarm
+// totals reported by trackers.
+func torrentStatus(torrent qbitorrent.TorrentInfo) string {
+	state := qbitorrent.TorrentInfoStateUnknown
+	if torrent.State != nil {
+		state = *torrent.State
+	}
+
+	return fmt.Sprintf("%s, seeds %d (%d), peers %d (%d)", state,
+		flatInt(torrent.NumSeeds), flatInt(torrent.NumComplete),
+		flatInt(torrent.NumLeechs), flatInt(torrent.NumIncomplete))
+}
+
+// printStatus rewrites the current terminal line.
+func printStatus(format string, args ...any) {
+	fmt.Printf("\r%-79s", fmt.Sprintf(format, args...))
+}
+
+func flatInt(item *int64) int64 {
+	if item == nil {
+		return 0
+	}
+
+	return *item
+}
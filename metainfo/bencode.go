@@ -0,0 +1,198 @@
+package metainfo
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// nesting limit protecting from stack overflow on malicious input
+const maxDepth = 64
+
+var ErrInvalidBencode = errors.New("invalid bencode")
+
+// Decode parses bencoded data. Integers are returned as int64, byte strings
+// as string, lists as []any and dictionaries as map[string]any.
+func Decode(data []byte) (any, error) {
+	d := decoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.pos != len(d.data) {
+		return nil, d.errorf("trailing data")
+	}
+
+	return value, nil
+}
+
+type decoder struct {
+	data  []byte
+	pos   int
+	depth int
+
+	// position of the "info" value of the top level dictionary, info hash is
+	// computed from these bytes exactly as they are in the file
+	infoStart int
+	infoEnd   int
+}
+
+func (d *decoder) decode() (any, error) {
+	if d.pos >= len(d.data) {
+		return nil, d.errorf("unexpected end of data")
+	}
+
+	switch c := d.data[d.pos]; {
+	case c == 'i':
+		return d.decodeInt()
+	case c == 'l':
+		return d.decodeList()
+	case c == 'd':
+		return d.decodeDict()
+	case c >= '0' && c <= '9':
+		return d.decodeString()
+	default:
+		return nil, d.errorf("unexpected character %q", c)
+	}
+}
+
+func (d *decoder) decodeInt() (int64, error) {
+	// skip 'i'
+	d.pos++
+
+	end := d.indexFrom('e')
+	if end == -1 {
+		return 0, d.errorf("unterminated integer")
+	}
+
+	str := string(d.data[d.pos:end])
+	if str == "" || str == "-0" || (len(str) > 1 && str[0] == '0') || (len(str) > 2 && str[:2] == "-0") {
+		return 0, d.errorf("malformed integer %q", str)
+	}
+
+	value, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, d.errorf("malformed integer %q", str)
+	}
+
+	d.pos = end + 1
+
+	return value, nil
+}
+
+func (d *decoder) decodeString() (string, error) {
+	colon := d.indexFrom(':')
+	if colon == -1 {
+		return "", d.errorf("unterminated string length")
+	}
+
+	str := string(d.data[d.pos:colon])
+	length, err := strconv.Atoi(str)
+	if err != nil || length < 0 || (len(str) > 1 && str[0] == '0') {
+		return "", d.errorf("malformed string length")
+	}
+
+	start := colon + 1
+	if length > len(d.data)-start {
+		return "", d.errorf("string is longer than data")
+	}
+
+	d.pos = start + length
+
+	return string(d.data[start:d.pos]), nil
+}
+
+func (d *decoder) decodeList() ([]any, error) {
+	err := d.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer d.leave()
+
+	list := make([]any, 0)
+	for {
+		if d.pos >= len(d.data) {
+			return nil, d.errorf("unterminated list")
+		}
+
+		if d.data[d.pos] == 'e' {
+			d.pos++
+			return list, nil
+		}
+
+		item, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+
+		list = append(list, item)
+	}
+}
+
+func (d *decoder) decodeDict() (map[string]any, error) {
+	err := d.enter()
+	if err != nil {
+		return nil, err
+	}
+	defer d.leave()
+
+	dict := make(map[string]any)
+	for {
+		if d.pos >= len(d.data) {
+			return nil, d.errorf("unterminated dictionary")
+		}
+
+		if d.data[d.pos] == 'e' {
+			d.pos++
+			return dict, nil
+		}
+
+		key, err := d.decodeString()
+		if err != nil {
+			return nil, err
+		}
+
+		start := d.pos
+		value, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+
+		if d.depth == 1 && key == "info" {
+			d.infoStart, d.infoEnd = start, d.pos
+		}
+
+		dict[key] = value
+	}
+}
+
+func (d *decoder) enter() error {
+	d.depth++
+	if d.depth > maxDepth {
+		return d.errorf("nesting is too deep")
+	}
+
+	// skip 'l' or 'd'
+	d.pos++
+
+	return nil
+}
+
+func (d *decoder) leave() {
+	d.depth--
+}
+
+func (d *decoder) indexFrom(c byte) int {
+	for i := d.pos; i < len(d.data); i++ {
+		if d.data[i] == c {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (d *decoder) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at %d: %s", ErrInvalidBencode, d.pos, fmt.Sprintf(format, args...))
+}
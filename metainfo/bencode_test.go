@@ -0,0 +1,106 @@
+package metainfo_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shadream/kftm/metainfo"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want any
+	}{
+		{name: "integer", data: "i42e", want: int64(42)},
+		{name: "zero", data: "i0e", want: int64(0)},
+		{name: "negative", data: "i-42e", want: int64(-42)},
+		{name: "max int64", data: "i9223372036854775807e", want: int64(9223372036854775807)},
+		{name: "min int64", data: "i-9223372036854775808e", want: int64(-9223372036854775808)},
+		{name: "string", data: "4:spam", want: "spam"},
+		{name: "empty string", data: "0:", want: ""},
+		{name: "binary string", data: "3:\x00\xff:", want: "\x00\xff:"},
+		{name: "empty list", data: "le", want: []any{}},
+		{name: "empty dictionary", data: "de", want: map[string]any{}},
+		{
+			name: "nested",
+			data: "d4:infod5:filesld6:lengthi5e4:pathl1:a5:b.mkveee4:name4:testee",
+			want: map[string]any{
+				"info": map[string]any{
+					"files": []any{map[string]any{
+						"length": int64(5),
+						"path":   []any{"a", "b.mkv"},
+					}},
+					"name": "test",
+				},
+			},
+		},
+		{name: "list of lists", data: "lli1eel0:ee", want: []any{[]any{int64(1)}, []any{""}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := metainfo.Decode([]byte(test.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %#v, want %#v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "empty", data: ""},
+		{name: "negative zero", data: "i-0e"},
+		{name: "leading zero", data: "i03e"},
+		{name: "negative leading zero", data: "i-03e"},
+		{name: "empty integer", data: "ie"},
+		{name: "only minus", data: "i-e"},
+		{name: "not a number", data: "i1x2e"},
+		{name: "overflow", data: "i9223372036854775808e"},
+		{name: "unterminated integer", data: "i42"},
+		{name: "string length leading zero", data: "04:spam"},
+		{name: "truncated string", data: "10:spam"},
+		{name: "unterminated string length", data: "4"},
+		{name: "truncated list", data: "li1e"},
+		{name: "truncated dictionary", data: "d3:key"},
+		{name: "truncated nested", data: "d4:infod4:name"},
+		{name: "integer key", data: "di1ei2ee"},
+		{name: "unknown type", data: "x"},
+		{name: "trailing data", data: "i1ei2e"},
+		{name: "too deep", data: strings.Repeat("l", 100) + strings.Repeat("e", 100)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := metainfo.Decode([]byte(test.data))
+			if !errors.Is(err, metainfo.ErrInvalidBencode) {
+				t.Errorf("got %#v, %v, want ErrInvalidBencode", got, err)
+			}
+		})
+	}
+}
+
+func TestParseRejectsTrailingData(t *testing.T) {
+	torrent := "d4:infod6:lengthi1e4:name5:a.mkvee"
+
+	_, err := metainfo.Parse([]byte(torrent))
+	if err != nil {
+		t.Fatalf("valid torrent: %v", err)
+	}
+
+	_, err = metainfo.Parse([]byte(torrent + "garbage"))
+	if !errors.Is(err, metainfo.ErrInvalidBencode) {
+		t.Errorf("got %v, want ErrInvalidBencode", err)
+	}
+}
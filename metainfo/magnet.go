@@ -0,0 +1,158 @@
+package metainfo
+
+import (
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-bittorrent/magneturi"
+)
+
+const (
+	btihPrefix = "urn:btih:"
+	btmhPrefix = "urn:btmh:"
+	// multihash header of sha2-256 digest: function code 0x12, length 0x20
+	sha256Multihash = "1220"
+)
+
+var ErrInvalidMagnet = errors.New("invalid magnet link")
+
+type Magnet struct {
+	Link string
+	// Display name, may be empty
+	Name string
+	// Hex encoded v1 info hash, empty for v2-only magnets
+	InfoHash string
+	// Hex encoded v2 info hash, empty for v1-only magnets
+	InfoHashV2 string
+}
+
+// Hash returns the hash qbitorrent identifies the torrent with: v1 hash or
+// v2 hash truncated to 40 characters for v2-only magnets.
+func (m *Magnet) Hash() string {
+	return qbitorrentHash(m.InfoHash, m.InfoHashV2)
+}
+
+// ParseMagnet reads info hashes from a magnet link. v1 hash may be hex or
+// base32 encoded, v2 hash is a sha256 multihash. Hybrid magnets carry both.
+func ParseMagnet(link string) (*Magnet, error) {
+	parsed, err := magneturi.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMagnet, err)
+	}
+
+	magnet := Magnet{
+		Link: link,
+		Name: parsed.DisplayName,
+	}
+
+	for _, topic := range parsed.ExactTopics {
+		topic, err = url.QueryUnescape(topic)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad xt %q", ErrInvalidMagnet, topic)
+		}
+
+		lower := strings.ToLower(topic)
+		switch {
+		case strings.HasPrefix(lower, btihPrefix):
+			hash, err := decodeBtih(topic[len(btihPrefix):])
+			if err != nil {
+				return nil, err
+			}
+
+			if magnet.InfoHash != "" && magnet.InfoHash != hash {
+				return nil, fmt.Errorf("%w: different v1 hashes %s and %s", ErrInvalidMagnet, magnet.InfoHash, hash)
+			}
+
+			magnet.InfoHash = hash
+		case strings.HasPrefix(lower, btmhPrefix):
+			hash, err := decodeBtmh(lower[len(btmhPrefix):])
+			if err != nil {
+				return nil, err
+			}
+
+			if magnet.InfoHashV2 != "" && magnet.InfoHashV2 != hash {
+				return nil, fmt.Errorf("%w: different v2 hashes %s and %s", ErrInvalidMagnet, magnet.InfoHashV2, hash)
+			}
+
+			magnet.InfoHashV2 = hash
+		}
+		// other topics, like ed2k or tree tiger hashes, are not for bittorrent
+	}
+
+	if magnet.InfoHash == "" && magnet.InfoHashV2 == "" {
+		return nil, fmt.Errorf("%w: no urn:btih or urn:btmh exact topic", ErrInvalidMagnet)
+	}
+
+	return &magnet, nil
+}
+
+// decodeBtih converts 40 characters hex or 32 characters base32 v1 hash to
+// lower case hex.
+func decodeBtih(value string) (string, error) {
+	switch len(value) {
+	case 40:
+		data, err := hex.DecodeString(value)
+		if err != nil {
+			return "", fmt.Errorf("%w: bad hex btih %q", ErrInvalidMagnet, value)
+		}
+
+		return hex.EncodeToString(data), nil
+	case 32:
+		data, err := base32.StdEncoding.DecodeString(strings.ToUpper(value))
+		if err != nil {
+			return "", fmt.Errorf("%w: bad base32 btih %q", ErrInvalidMagnet, value)
+		}
+
+		return hex.EncodeToString(data), nil
+	default:
+		return "", fmt.Errorf("%w: btih must be 40 hex or 32 base32 characters, got %d", ErrInvalidMagnet, len(value))
+	}
+}
+
+// decodeBtmh returns hex sha256 hash from hex encoded multihash.
+func decodeBtmh(value string) (string, error) {
+	hash, ok := strings.CutPrefix(value, sha256Multihash)
+	if !ok {
+		return "", fmt.Errorf("%w: btmh is not a sha256 multihash", ErrInvalidMagnet)
+	}
+
+	data, err := hex.DecodeString(hash)
+	if err != nil || len(data) != 32 {
+		return "", fmt.Errorf("%w: bad btmh %q", ErrInvalidMagnet, value)
+	}
+
+	return hash, nil
+}
+
+// ParseHash accepts a torrent hash as qbitorrent shows it, a full v2 hash or
+// a magnet link and returns the hash qbitorrent identifies the torrent with.
+func ParseHash(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "magnet:") {
+		magnet, err := ParseMagnet(value)
+		if err != nil {
+			return "", err
+		}
+
+		return magnet.Hash(), nil
+	}
+
+	data, err := hex.DecodeString(value)
+	if err != nil || (len(data) != 20 && len(data) != 32) {
+		return "", fmt.Errorf("%q is not a torrent hash: expected 40 or 64 hex characters", value)
+	}
+
+	return qbitorrentHash("", strings.ToLower(value)), nil
+}
+
+func qbitorrentHash(infoHash, infoHashV2 string) string {
+	if infoHash != "" {
+		return infoHash
+	}
+
+	return infoHashV2[:40]
+}
@@ -0,0 +1,171 @@
+package metainfo_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shadream/kftm/metainfo"
+)
+
+const (
+	testBtih       = "fc0e8975972b1b8480f9c34fcf6144b7a2748351"
+	testBtihBase32 = "7QHIS5MXFMNYJAHZYNH46YKEW6RHJA2R"
+	testBtmh       = "b04a172c2b5ad2cff2015dee7fee10d4d02a5b7ceabf6f17cf27a000944544bb"
+)
+
+func TestParseMagnet(t *testing.T) {
+	tests := []struct {
+		name       string
+		link       string
+		infoHash   string
+		infoHashV2 string
+		hash       string
+		display    string
+	}{
+		{
+			name:     "hex",
+			link:     "magnet:?xt=urn:btih:" + testBtih + "&dn=Movie",
+			infoHash: testBtih,
+			hash:     testBtih,
+			display:  "Movie",
+		},
+		{
+			name:     "upper case hex",
+			link:     "magnet:?xt=urn:btih:" + strings.ToUpper(testBtih),
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:     "base32",
+			link:     "magnet:?xt=urn:btih:" + testBtihBase32,
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:     "lower case base32",
+			link:     "magnet:?xt=urn:btih:" + strings.ToLower(testBtihBase32),
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:     "upper case urn",
+			link:     "magnet:?xt=URN:BTIH:" + testBtih,
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:       "v2",
+			link:       "magnet:?xt=urn:btmh:1220" + testBtmh + "&dn=Movie",
+			infoHashV2: testBtmh,
+			hash:       testBtmh[:40],
+			display:    "Movie",
+		},
+		{
+			name:       "mixed case v2",
+			link:       "magnet:?xt=urn:btmh:1220" + strings.ToUpper(testBtmh),
+			infoHashV2: testBtmh,
+			hash:       testBtmh[:40],
+		},
+		{
+			name:       "hybrid",
+			link:       "magnet:?xt=urn:btih:" + testBtih + "&xt=urn:btmh:1220" + testBtmh,
+			infoHash:   testBtih,
+			infoHashV2: testBtmh,
+			hash:       testBtih,
+		},
+		{
+			name:     "same hash twice",
+			link:     "magnet:?xt=urn:btih:" + testBtih + "&xt=urn:btih:" + testBtihBase32,
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:     "other topics",
+			link:     "magnet:?xt=urn:ed2k:31d6cfe0d16ae931b73c59d7e0c089c0&xt=urn:btih:" + testBtih,
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+		{
+			name:     "spaces around",
+			link:     "  magnet:?xt=urn:btih:" + testBtih + "\n",
+			infoHash: testBtih,
+			hash:     testBtih,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			magnet, err := metainfo.ParseMagnet(test.link)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if magnet.InfoHash != test.infoHash || magnet.InfoHashV2 != test.infoHashV2 {
+				t.Errorf("btih %q, btmh %q, want %q, %q", magnet.InfoHash, magnet.InfoHashV2, test.infoHash, test.infoHashV2)
+			}
+			if magnet.Hash() != test.hash {
+				t.Errorf("hash %s, want %s", magnet.Hash(), test.hash)
+			}
+			if magnet.Name != test.display {
+				t.Errorf("name %q, want %q", magnet.Name, test.display)
+			}
+		})
+	}
+}
+
+func TestParseMagnetInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+	}{
+		{name: "not a magnet", link: "http://tracker.example/" + testBtih},
+		{name: "no exact topic", link: "magnet:?dn=Movie"},
+		{name: "no bittorrent topic", link: "magnet:?xt=urn:ed2k:31d6cfe0d16ae931b73c59d7e0c089c0"},
+		{name: "short hex", link: "magnet:?xt=urn:btih:" + testBtih[:39]},
+		{name: "bad hex", link: "magnet:?xt=urn:btih:" + testBtih[:39] + "z"},
+		{name: "bad base32", link: "magnet:?xt=urn:btih:" + testBtihBase32[:31] + "1"},
+		{name: "btmh without multihash header", link: "magnet:?xt=urn:btmh:" + testBtmh},
+		{name: "sha1 multihash", link: "magnet:?xt=urn:btmh:1114" + testBtih},
+		{name: "short btmh", link: "magnet:?xt=urn:btmh:1220" + testBtmh[:62]},
+		{name: "different hashes", link: "magnet:?xt=urn:btih:" + testBtih + "&xt=urn:btih:" + strings.Repeat("0", 40)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			magnet, err := metainfo.ParseMagnet(test.link)
+			if !errors.Is(err, metainfo.ErrInvalidMagnet) {
+				t.Errorf("got %+v, %v, want ErrInvalidMagnet", magnet, err)
+			}
+		})
+	}
+}
+
+func TestParseHash(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: testBtih, want: testBtih},
+		{value: strings.ToUpper(testBtih), want: testBtih},
+		{value: " " + testBtih + "\n", want: testBtih},
+		{value: testBtmh, want: testBtmh[:40]},
+		{value: strings.ToUpper(testBtmh), want: testBtmh[:40]},
+		{value: "magnet:?xt=urn:btih:" + testBtihBase32, want: testBtih},
+		{value: "magnet:?xt=urn:btmh:1220" + testBtmh, want: testBtmh[:40]},
+	}
+
+	for _, test := range tests {
+		got, err := metainfo.ParseHash(test.value)
+		if err != nil || got != test.want {
+			t.Errorf("ParseHash(%q) = %q, %v, want %q", test.value, got, err, test.want)
+		}
+	}
+
+	for _, value := range []string{"", testBtih[:39], testBtih + "0", testBtih[:39] + "z", testBtihBase32, "magnet:?dn=Movie"} {
+		got, err := metainfo.ParseHash(value)
+		if err == nil {
+			t.Errorf("ParseHash(%q) = %q, want error", value, got)
+		}
+	}
+}
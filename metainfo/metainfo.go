@@ -0,0 +1,279 @@
+// Package metainfo reads .torrent files: BitTorrent v1, v2 and hybrid.
+package metainfo
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrNoInfo      = errors.New("torrent has no info dictionary")
+	ErrInvalidInfo = errors.New("invalid info dictionary")
+)
+
+type File struct {
+	// Slash separated path as qbitorrent shows it: multi-file torrents start
+	// with the torrent name folder
+	Path   string
+	Length int64
+	// Index of the file in qbitorrent file list
+	Index int
+}
+
+type MetaInfo struct {
+	Name     string
+	Announce string
+	// Hex encoded sha1 of the info dictionary, empty for v2-only torrents
+	InfoHash string
+	// Hex encoded sha256 of the info dictionary, empty for v1-only torrents
+	InfoHashV2 string
+	// Files without BEP 47 padding files
+	Files []File
+	// Torrent stores files in a folder named after the torrent
+	MultiFile bool
+}
+
+// Hash returns the hash qbitorrent identifies the torrent with: v1 hash or
+// v2 hash truncated to 40 characters for v2-only torrents.
+func (m *MetaInfo) Hash() string {
+	return qbitorrentHash(m.InfoHash, m.InfoHashV2)
+}
+
+func (m *MetaInfo) IsHybrid() bool {
+	return m.InfoHash != "" && m.InfoHashV2 != ""
+}
+
+func (m *MetaInfo) TotalLength() int64 {
+	var total int64
+	for _, file := range m.Files {
+		total += file.Length
+	}
+
+	return total
+}
+
+func ReadFile(path string) (*MetaInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read torrent file: %w", err)
+	}
+
+	return Parse(data)
+}
+
+// Parse reads contents of a .torrent file.
+func Parse(data []byte) (*MetaInfo, error) {
+	d := decoder{data: data}
+	value, err := d.decode()
+	if err != nil {
+		return nil, err
+	}
+
+	if d.pos != len(d.data) {
+		return nil, d.errorf("trailing data")
+	}
+
+	root, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: torrent is not a dictionary", ErrInvalidBencode)
+	}
+
+	info, ok := root["info"].(map[string]any)
+	if !ok {
+		return nil, ErrNoInfo
+	}
+
+	rawInfo := data[d.infoStart:d.infoEnd]
+
+	result := MetaInfo{}
+	result.Announce, _ = root["announce"].(string)
+	result.Name, _ = info["name"].(string)
+	if result.Name == "" {
+		return nil, fmt.Errorf("%w: no name", ErrInvalidInfo)
+	}
+
+	version, _ := info["meta version"].(int64)
+	_, hasV1Files := info["files"]
+	_, hasV1Length := info["length"]
+	isV1 := hasV1Files || hasV1Length
+	isV2 := version == 2
+
+	if !isV1 && !isV2 {
+		return nil, fmt.Errorf("%w: neither v1 files nor v2 file tree", ErrInvalidInfo)
+	}
+
+	if isV1 {
+		hash := sha1.Sum(rawInfo)
+		result.InfoHash = hex.EncodeToString(hash[:])
+		result.Files, result.MultiFile, err = v1Files(result.Name, info)
+	}
+	if isV2 {
+		hash := sha256.Sum256(rawInfo)
+		result.InfoHashV2 = hex.EncodeToString(hash[:])
+		// hybrid torrents list the same files in both forms, qbitorrent
+		// follows v1 order for them
+		if !isV1 {
+			result.Files, result.MultiFile, err = v2Files(result.Name, info)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func v1Files(name string, info map[string]any) ([]File, bool, error) {
+	if length, ok := info["length"].(int64); ok {
+		return []File{{Path: name, Length: length}}, false, nil
+	}
+
+	list, ok := info["files"].([]any)
+	if !ok {
+		return nil, false, fmt.Errorf("%w: files is not a list", ErrInvalidInfo)
+	}
+
+	files := make([]File, 0, len(list))
+	for index, item := range list {
+		dict, ok := item.(map[string]any)
+		if !ok {
+			return nil, false, fmt.Errorf("%w: file %d is not a dictionary", ErrInvalidInfo, index)
+		}
+
+		// BEP 47 padding files are not shown by qbitorrent
+		if attr, _ := dict["attr"].(string); strings.Contains(attr, "p") {
+			continue
+		}
+
+		length, ok := dict["length"].(int64)
+		if !ok {
+			return nil, false, fmt.Errorf("%w: file %d has no length", ErrInvalidInfo, index)
+		}
+
+		parts, err := pathParts(dict["path"])
+		if err != nil {
+			return nil, false, fmt.Errorf("file %d: %w", index, err)
+		}
+
+		files = append(files, File{
+			Path:   path.Join(append([]string{name}, parts...)...),
+			Length: length,
+			Index:  len(files),
+		})
+	}
+
+	return files, true, nil
+}
+
+func v2Files(name string, info map[string]any) ([]File, bool, error) {
+	tree, ok := info["file tree"].(map[string]any)
+	if !ok {
+		return nil, false, fmt.Errorf("%w: file tree is not a dictionary", ErrInvalidInfo)
+	}
+
+	var files []File
+	err := walkFileTree(tree, nil, &files)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// single file torrent has only the file named after the torrent
+	if len(files) == 1 && files[0].Path == name {
+		return files, false, nil
+	}
+
+	for i := range files {
+		files[i].Path = path.Join(name, files[i].Path)
+		files[i].Index = i
+	}
+
+	return files, true, nil
+}
+
+// walkFileTree collects files of v2 file tree in key order. File entries are
+// dictionaries with an empty key holding file properties.
+func walkFileTree(tree map[string]any, dir []string, files *[]File) error {
+	names := make([]string, 0, len(tree))
+	for name := range tree {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		node, ok := tree[name].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: file tree node %q is not a dictionary", ErrInvalidInfo, name)
+		}
+
+		if name == "" {
+			continue
+		}
+
+		if err := validPathPart(name); err != nil {
+			return err
+		}
+
+		current := append(slices.Clone(dir), name)
+
+		if props, ok := node[""].(map[string]any); ok {
+			length, _ := props["length"].(int64)
+			*files = append(*files, File{Path: path.Join(current...), Length: length})
+			continue
+		}
+
+		err := walkFileTree(node, current, files)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func pathParts(value any) ([]string, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: path is not a list", ErrInvalidInfo)
+	}
+
+	parts := make([]string, 0, len(list))
+	for _, item := range list {
+		part, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: path element is not a string", ErrInvalidInfo)
+		}
+
+		// some clients write empty elements, qbitorrent skips them
+		if part == "" {
+			continue
+		}
+
+		if err := validPathPart(part); err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+	}
+
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidInfo)
+	}
+
+	return parts, nil
+}
+
+// validPathPart rejects elements that would escape the torrent folder.
+func validPathPart(part string) error {
+	if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `/\`) {
+		return fmt.Errorf("%w: bad path element %q", ErrInvalidInfo, part)
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package metainfo_test
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/shadream/kftm/metainfo"
+)
+
+// Torrents in testdata are built by a separate bencoder, their hashes are
+// sha1 and sha256 of the info dictionary computed by it.
+func TestReadFile(t *testing.T) {
+	tests := []struct {
+		file       string
+		infoHash   string
+		infoHashV2 string
+		hash       string
+		multiFile  bool
+		files      []metainfo.File
+	}{
+		{
+			file:     "v1_single.torrent",
+			infoHash: "fc0e8975972b1b8480f9c34fcf6144b7a2748351",
+			hash:     "fc0e8975972b1b8480f9c34fcf6144b7a2748351",
+			files:    []metainfo.File{{Path: "movie.mkv", Length: 1000, Index: 0}},
+		},
+		{
+			file:      "v1_multi.torrent",
+			infoHash:  "b2e1886da7e5d761abe55c7b2a58531c3d641729",
+			hash:      "b2e1886da7e5d761abe55c7b2a58531c3d641729",
+			multiFile: true,
+			// the padding file is skipped and does not take an index
+			files: []metainfo.File{
+				{Path: "Movie/movie.mkv", Length: 1000, Index: 0},
+				{Path: "Movie/Subs/rus.srt", Length: 100, Index: 1},
+			},
+		},
+		{
+			file:       "v2_single.torrent",
+			infoHashV2: "b5a116a9eb837a1c9c9caba6a8a38d314d7db5d2e5c27f8e5bce602395205848",
+			hash:       "b5a116a9eb837a1c9c9caba6a8a38d314d7db5d2",
+			files:      []metainfo.File{{Path: "movie.mkv", Length: 1000, Index: 0}},
+		},
+		{
+			file:       "v2_multi.torrent",
+			infoHashV2: "4d2acff2afb3541a27a8def5da73346e6f2eed4c71e758508c4bb45fe1fa6166",
+			hash:       "4d2acff2afb3541a27a8def5da73346e6f2eed4c",
+			multiFile:  true,
+			// file tree is ordered by names
+			files: []metainfo.File{
+				{Path: "Movie/Subs/rus.srt", Length: 100, Index: 0},
+				{Path: "Movie/movie.mkv", Length: 1000, Index: 1},
+			},
+		},
+		{
+			file:       "hybrid.torrent",
+			infoHash:   "728eff3bfd00a4bcd47c411586415aa3c196e371",
+			infoHashV2: "b04a172c2b5ad2cff2015dee7fee10d4d02a5b7ceabf6f17cf27a000944544bb",
+			hash:       "728eff3bfd00a4bcd47c411586415aa3c196e371",
+			multiFile:  true,
+			// v1 order of the files
+			files: []metainfo.File{
+				{Path: "Movie/movie.mkv", Length: 1000, Index: 0},
+				{Path: "Movie/Subs/rus.srt", Length: 100, Index: 1},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			info, err := metainfo.ReadFile(filepath.Join("testdata", test.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if info.InfoHash != test.infoHash || info.InfoHashV2 != test.infoHashV2 {
+				t.Errorf("btih %q, btmh %q, want %q, %q", info.InfoHash, info.InfoHashV2, test.infoHash, test.infoHashV2)
+			}
+			if info.Hash() != test.hash {
+				t.Errorf("hash %s, want %s", info.Hash(), test.hash)
+			}
+			if info.IsHybrid() != (test.infoHash != "" && test.infoHashV2 != "") {
+				t.Errorf("hybrid %v", info.IsHybrid())
+			}
+			if info.MultiFile != test.multiFile {
+				t.Errorf("multi file %v, want %v", info.MultiFile, test.multiFile)
+			}
+			if !slices.Equal(info.Files, test.files) {
+				t.Errorf("files %+v, want %+v", info.Files, test.files)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{name: "not a dictionary", data: "li1ee", err: metainfo.ErrInvalidBencode},
+		{name: "no info", data: "d8:announce3:urle", err: metainfo.ErrNoInfo},
+		{name: "no name", data: "d4:infod6:lengthi1eee", err: metainfo.ErrInvalidInfo},
+		{name: "no files", data: "d4:infod4:name1:aee", err: metainfo.ErrInvalidInfo},
+		{name: "escaping path", data: "d4:infod5:filesld6:lengthi1e4:pathl2:..1:aeee4:name1:aee", err: metainfo.ErrInvalidInfo},
+		{name: "empty path", data: "d4:infod5:filesld6:lengthi1e4:pathl0:eee4:name1:aee", err: metainfo.ErrInvalidInfo},
+		{name: "file without length", data: "d4:infod5:filesld4:pathl1:beee4:name1:aee", err: metainfo.ErrInvalidInfo},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := metainfo.Parse([]byte(test.data))
+			if !errors.Is(err, test.err) {
+				t.Errorf("got %v, want %v", err, test.err)
+			}
+		})
+	}
+}
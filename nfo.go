@@ -1,6 +1,23 @@
 package main
 
-import "encoding/xml"
+import (
+	"cmp"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	nfoRootMovie  = "movie"
+	nfoRootTvShow = "tvshow"
+)
+
+var ErrUnknownNfo = errors.New("unknown nfo root element")
 
 type Ratings struct {
 	Text   string   `xml:",chardata"`
@@ -9,19 +26,33 @@ type Ratings struct {
 
 type Rating struct {
 	Text    string `xml:",chardata"`
-	Name    string `xml:"name,attr"`
-	Max     string `xml:"max,attr"`
-	Default string `xml:"default,attr"`
+	Name    string `xml:"name,attr,omitempty"`
+	Max     string `xml:"max,attr,omitempty"`
+	Default string `xml:"default,attr,omitempty"`
 	Value   string `xml:"value"`
 	Votes   string `xml:"votes"`
 }
 
 type Thumb struct {
 	Text    string `xml:",chardata"`
-	Spoof   string `xml:"spoof,attr"`
-	Cache   string `xml:"cache,attr"`
-	Aspect  string `xml:"aspect,attr"`
-	Preview string `xml:"preview,attr"`
+	Spoof   string `xml:"spoof,attr,omitempty"`
+	Cache   string `xml:"cache,attr,omitempty"`
+	Aspect  string `xml:"aspect,attr,omitempty"`
+	Preview string `xml:"preview,attr,omitempty"`
+}
+
+type UniqueID struct {
+	Type    string `xml:"type,attr"`
+	Default string `xml:"default,attr,omitempty"`
+	Value   string `xml:",chardata"`
+}
+
+// RawElement holds an element MovieNfo has no field for, so that files
+// written by Kodi, Jellyfin or the user survive a read and write back.
+type RawElement struct {
+	XMLName xml.Name
+	Attr    []xml.Attr `xml:",any,attr"`
+	Inner   string     `xml:",innerxml"`
 }
 
 type Actor struct {
@@ -32,9 +63,11 @@ type Actor struct {
 	Thumb string `xml:"thumb"`
 }
 
+// MovieNfo is a Kodi/Jellyfin nfo document. XMLName is either "movie" or
+// "tvshow", both kinds share the same set of elements.
 type MovieNfo struct {
-	XMLName xml.Name `xml:"movie"`
-	Text    string   `xml:",chardata"`
+	XMLName xml.Name
+	Text    string `xml:",chardata"`
 	// The title for the movie
 	Title         string  `xml:"title"`
 	Originaltitle string  `xml:"originaltitle"`
@@ -56,13 +89,278 @@ type MovieNfo struct {
 	//  - <thumb aspect="keyart"
 	//  - <thumb aspect="landscape"
 	//  - <thumb aspect="poster"
-	Thumb     []Thumb  `xml:"thumb"`
-	Genre     string   `xml:"genre"`
-	Country   []string `xml:"country"`
-	Director  string   `xml:"director"`
-	Premiered string   `xml:"premiered"`
+	Thumb     []Thumb    `xml:"thumb"`
+	Uniqueid  []UniqueID `xml:"uniqueid"`
+	Genre     []string   `xml:"genre"`
+	Tag       []string   `xml:"tag"`
+	Country   []string   `xml:"country"`
+	Director  []string   `xml:"director"`
+	Premiered string     `xml:"premiered"`
 	// Note: Kodi v17: Tag deprecated, use <premiered> tag instead. Note: Kodi v20: Use <premiered> tag only.
-	Year   string  `xml:"year"`
-	Studio string  `xml:"studio"`
-	Actor  []Actor `xml:"actor"`
+	Year   string   `xml:"year"`
+	Studio []string `xml:"studio"`
+	Actor  []Actor  `xml:"actor"`
+	// Jellyfin: "true" forbids any metadata updates of the item
+	Lockdata string `xml:"lockdata,omitempty"`
+	// Jellyfin: pipe separated list of fields that must not be updated, e.g. "Cast|Overview"
+	Lockedfields string `xml:"lockedfields,omitempty"`
+	// Elements without a dedicated field, kept as is.
+	Extra []RawElement `xml:",any"`
+}
+
+func (n MovieNfo) IsTvShow() bool {
+	return n.XMLName.Local == nfoRootTvShow
+}
+
+// UniqueID returns value of <uniqueid type="...">. Older files keep ids in
+// elements like <kinopoiskid> or <imdbid>, they are checked as a fallback.
+func (n MovieNfo) UniqueID(idType string) string {
+	for _, id := range n.Uniqueid {
+		if strings.EqualFold(id.Type, idType) {
+			return strings.TrimSpace(id.Value)
+		}
+	}
+
+	for _, element := range n.Extra {
+		if strings.EqualFold(element.XMLName.Local, idType+"id") {
+			return strings.TrimSpace(element.Inner)
+		}
+	}
+
+	return ""
+}
+
+// KinopoiskID returns kinopoisk id stored in nfo or -1 if there is none.
+func (n MovieNfo) KinopoiskID() int {
+	id, err := strconv.Atoi(n.UniqueID("kinopoisk"))
+	if err != nil {
+		return -1
+	}
+
+	return id
+}
+
+func (n MovieNfo) ImdbID() string {
+	return n.UniqueID("imdb")
+}
+
+// NfoFields selects groups of elements that are taken from fresh data by
+// MergeNfo.
+type NfoFields uint
+
+const (
+	NfoRatings NfoFields = 1 << iota
+	// outline, plot and tagline
+	NfoPlot
+	// actors and directors
+	NfoCast
+	NfoArtwork
+	// titles, genres, countries, dates, studios and ids
+	NfoDetails
+
+	NfoAll = NfoRatings | NfoPlot | NfoCast | NfoArtwork | NfoDetails
+)
+
+// lockedFields maps Jellyfin lockedfields names to the groups they belong to.
+var lockedFields = map[string]NfoFields{
+	"name":                NfoDetails,
+	"originaltitle":       NfoDetails,
+	"genres":              NfoDetails,
+	"productionlocations": NfoDetails,
+	"studios":             NfoDetails,
+	"overview":            NfoPlot,
+	"cast":                NfoCast,
+}
+
+func (n MovieNfo) IsLocked() bool {
+	return strings.EqualFold(strings.TrimSpace(n.Lockdata), "true")
+}
+
+// LockedFields returns field groups that must not be updated.
+func (n MovieNfo) LockedFields() NfoFields {
+	if n.IsLocked() {
+		return NfoAll
+	}
+
+	var locked NfoFields
+	for _, name := range strings.Split(n.Lockedfields, "|") {
+		locked |= lockedFields[strings.ToLower(strings.TrimSpace(name))]
+	}
+
+	return locked
+}
+
+// ParseNfoFields parses comma separated list of field groups: ratings, plot,
+// cast, artwork, details or all.
+func ParseNfoFields(str string) (NfoFields, error) {
+	names := map[string]NfoFields{
+		"ratings": NfoRatings,
+		"plot":    NfoPlot,
+		"cast":    NfoCast,
+		"artwork": NfoArtwork,
+		"details": NfoDetails,
+		"all":     NfoAll,
+	}
+
+	var fields NfoFields
+	for _, name := range strings.Split(str, ",") {
+		field, ok := names[strings.TrimSpace(name)]
+		if !ok {
+			return 0, fmt.Errorf("unknown nfo field group %q", name)
+		}
+
+		fields |= field
+	}
+
+	return fields, nil
+}
+
+// MergeNfo updates selected fields of existing nfo with fresh ones. Fields
+// that kftm never fills (sorttitle, unknown elements) and additions made by
+// the user (tags, ratings and ids from other sources) are kept.
+func MergeNfo(existing, fresh MovieNfo, fields NfoFields) MovieNfo {
+	result := existing
+	if result.XMLName.Local == "" {
+		result.XMLName = fresh.XMLName
+	}
+
+	if fields&NfoRatings != 0 {
+		result.Ratings.Rating = mergeBy(existing.Ratings.Rating, fresh.Ratings.Rating,
+			func(item Rating) string { return item.Name })
+	}
+
+	if fields&NfoPlot != 0 {
+		result.Outline = fresh.Outline
+		result.Plot = fresh.Plot
+		result.Tagline = fresh.Tagline
+	}
+
+	if fields&NfoCast != 0 {
+		result.Director = fresh.Director
+		result.Actor = fresh.Actor
+	}
+
+	if fields&NfoArtwork != 0 {
+		result.Thumb = mergeBy(existing.Thumb, fresh.Thumb,
+			func(item Thumb) string { return item.Aspect })
+	}
+
+	if fields&NfoDetails != 0 {
+		result.Title = fresh.Title
+		result.Originaltitle = fresh.Originaltitle
+		result.Genre = fresh.Genre
+		result.Country = fresh.Country
+		// kinopoisk has no premiere of some movies, known dates are kept
+		result.Premiered = cmp.Or(fresh.Premiered, existing.Premiered)
+		result.Year = cmp.Or(fresh.Year, existing.Year)
+		if len(fresh.Studio) != 0 {
+			result.Studio = fresh.Studio
+		}
+		result.Uniqueid = mergeBy(existing.Uniqueid, fresh.Uniqueid,
+			func(item UniqueID) string { return strings.ToLower(item.Type) })
+	}
+
+	for _, tag := range fresh.Tag {
+		if !slices.Contains(result.Tag, tag) {
+			result.Tag = append(result.Tag, tag)
+		}
+	}
+
+	return result
+}
+
+// mergeBy replaces items of existing with fresh items having the same key,
+// items with new keys are appended.
+func mergeBy[T any](existing, fresh []T, key func(T) string) []T {
+	result := slices.Clone(existing)
+	for _, item := range fresh {
+		index := slices.IndexFunc(result, func(old T) bool { return key(old) == key(item) })
+		if index == -1 {
+			result = append(result, item)
+			continue
+		}
+
+		result[index] = item
+	}
+
+	return result
+}
+
+// ReadNfo parses movie or tvshow nfo file.
+func ReadNfo(path string) (*MovieNfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read nfo file: %w", err)
+	}
+
+	var nfo MovieNfo
+	err = xml.Unmarshal(data, &nfo)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal nfo %s: %w", path, err)
+	}
+
+	if nfo.XMLName.Local != nfoRootMovie && nfo.XMLName.Local != nfoRootTvShow {
+		return nil, fmt.Errorf("%s: <%s>: %w", path, nfo.XMLName.Local, ErrUnknownNfo)
+	}
+
+	nfo.trimSpace()
+
+	return &nfo, nil
+}
+
+// WriteNfo writes nfo to path, replacing the file if it exists.
+func WriteNfo(path string, nfo MovieNfo) error {
+	if nfo.XMLName.Local == "" {
+		nfo.XMLName.Local = nfoRootMovie
+	}
+
+	data, err := xml.Marshal(nfo)
+	if err != nil {
+		return fmt.Errorf("marshal nfo: %w", err)
+	}
+
+	data = []byte(xml.Header + string(data))
+
+	err = os.WriteFile(path, data, 0o755)
+	if err != nil {
+		return fmt.Errorf("write nfo file: %w", err)
+	}
+
+	return nil
+}
+
+// FindNfo returns path of the nfo file describing the folder: tvshow.nfo,
+// movie.nfo, nfo named after the folder or the first nfo file in it.
+func FindNfo(dir string) (string, error) {
+	folderNfo := filepath.Base(dir) + ".nfo"
+	for _, name := range []string{"tvshow.nfo", "movie.nfo", folderNfo} {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.nfo"))
+	if err != nil {
+		return "", fmt.Errorf("search nfo files: %w", err)
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("nfo file in %s: %w", dir, os.ErrNotExist)
+	}
+
+	return matches[0], nil
+}
+
+// trimSpace drops indentation that was read into chardata fields, otherwise
+// it piles up at the beginning of elements when the file is written back.
+func (n *MovieNfo) trimSpace() {
+	n.Text = strings.TrimSpace(n.Text)
+	n.Ratings.Text = strings.TrimSpace(n.Ratings.Text)
+	for i := range n.Ratings.Rating {
+		n.Ratings.Rating[i].Text = strings.TrimSpace(n.Ratings.Rating[i].Text)
+	}
+	for i := range n.Actor {
+		n.Actor[i].Text = strings.TrimSpace(n.Actor[i].Text)
+	}
 }
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/kinopoisk/kinopoisktest"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files in testdata")
+
+// recordedMovie returns the movie recorded by kinopoisktest, read by the
+// client like the real one.
+func recordedMovie(t *testing.T, id int) kinopoisk.Movie {
+	t.Helper()
+
+	srv := kinopoisktest.NewServer()
+	t.Cleanup(srv.Close)
+
+	client := kinopoisk.NewClient(kinopoisktest.DefaultToken)
+	client.BaseUrl = srv.URL
+
+	movie, err := client.GetById(id)
+	if err != nil {
+		t.Fatalf("get movie: %v", err)
+	}
+
+	return *movie
+}
+
+func TestKinopoiskDtoToNfoGolden(t *testing.T) {
+	for _, id := range kinopoisktest.MovieIDs() {
+		t.Run(strconv.Itoa(id), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "movie.nfo")
+			err := WriteNfo(path, KinopoiskDtoToNfo(recordedMovie(t, id)))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := filepath.Join("testdata", "golden", fmt.Sprintf("movie_%d.nfo", id))
+			if *update {
+				err = os.WriteFile(golden, got, 0o644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v, run go test -update to create it", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("nfo differs from %s:\ngot  %s\nwant %s", golden, got, want)
+			}
+		})
+	}
+}
+
+func TestMovieName(t *testing.T) {
+	tests := []struct {
+		id   int
+		want string
+	}{
+		{id: 326, want: "Побег из Шоушенка (1994)"},
+		{id: 464963, want: "Игра престолов (2011)"},
+		// no premiere yet, the production year is used
+		{id: 5304486, want: "Мастер и Маргарита 2 (2027)"},
+	}
+
+	for _, test := range tests {
+		got := movieName(recordedMovie(t, test.id))
+		if got != test.want {
+			t.Errorf("movieName(%d) = %q, want %q", test.id, got, test.want)
+		}
+	}
+}
+
+func TestMergeNfoKeepsKnownPremiere(t *testing.T) {
+	existing := MovieNfo{Title: "Мастер и Маргарита 2", Premiered: "2027-01-01", Year: "2027"}
+	fresh := KinopoiskDtoToNfo(recordedMovie(t, 5304486))
+
+	merged := MergeNfo(existing, fresh, NfoDetails)
+	if merged.Premiered != "2027-01-01" || merged.Year != "2027" {
+		t.Errorf("premiered %q of %q, want the existing ones", merged.Premiered, merged.Year)
+	}
+
+	fresh.Premiered = "2027-03-08"
+	merged = MergeNfo(existing, fresh, NfoDetails)
+	if merged.Premiered != "2027-03-08" {
+		t.Errorf("premiered %q, want the fresh one", merged.Premiered)
+	}
+}
+
+// testdata/nfo/edited.nfo is written by kftm and then edited by the user
+// and Jellyfin: sort title, tags, a trakt rating, a collection and stream
+// details, with the cast locked.
+const editedNfo = "testdata/nfo/edited.nfo"
+
+func TestNfoRoundTripIsByteStable(t *testing.T) {
+	nfo, err := ReadNfo(editedNfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "movie.nfo")
+	err = WriteNfo(path, *nfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := os.ReadFile(path)
+	want, _ := os.ReadFile(editedNfo)
+	if !bytes.Equal(got, want) {
+		t.Errorf("nfo changed by reading and writing:\ngot  %s\nwant %s", got, want)
+	}
+}
+
+func TestMergeNfoKeepsUserEdits(t *testing.T) {
+	existing, err := ReadNfo(editedNfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locked := existing.LockedFields()
+	if locked != NfoCast {
+		t.Fatalf("locked fields %b, want cast", locked)
+	}
+
+	merged := MergeNfo(*existing, KinopoiskDtoToNfo(recordedMovie(t, 326)), NfoAll&^locked)
+
+	if len(merged.Actor) != 1 || merged.Actor[0].Role != "Энди Дюфрейн" {
+		t.Errorf("locked cast is updated: %+v", merged.Actor)
+	}
+	if merged.Plot == existing.Plot {
+		t.Error("plot is not updated")
+	}
+	if merged.Sorttitle != existing.Sorttitle || !slices.Equal(merged.Tag[:2], existing.Tag) {
+		t.Errorf("sort title %q and tags %q are not kept", merged.Sorttitle, merged.Tag)
+	}
+	if !slices.ContainsFunc(merged.Ratings.Rating, func(rating Rating) bool { return rating.Name == "trakt" }) {
+		t.Errorf("trakt rating is dropped: %+v", merged.Ratings.Rating)
+	}
+
+	path := filepath.Join(t.TempDir(), "movie.nfo")
+	err = WriteNfo(path, merged)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _ := os.ReadFile(path)
+	original, _ := os.ReadFile(editedNfo)
+	for _, element := range []string{"set", "fileinfo", "dateadded"} {
+		start := bytes.Index(original, []byte("<"+element+">"))
+		end := bytes.Index(original, []byte("</"+element+">"))
+		if !bytes.Contains(got, original[start:end]) {
+			t.Errorf("<%s> is not kept as is", element)
+		}
+	}
+}
+
+func TestLockedFields(t *testing.T) {
+	tests := []struct {
+		nfo  MovieNfo
+		want NfoFields
+	}{
+		{nfo: MovieNfo{}, want: 0},
+		{nfo: MovieNfo{Lockdata: "true"}, want: NfoAll},
+		{nfo: MovieNfo{Lockedfields: "Cast|Overview"}, want: NfoCast | NfoPlot},
+		{nfo: MovieNfo{Lockedfields: " genres | Name "}, want: NfoDetails},
+		// fields kftm does not fill
+		{nfo: MovieNfo{Lockedfields: "OfficialRating|Tags"}, want: 0},
+	}
+
+	for _, test := range tests {
+		got := test.nfo.LockedFields()
+		if got != test.want {
+			t.Errorf("LockedFields(%q, %q) = %b, want %b", test.nfo.Lockdata, test.nfo.Lockedfields, got, test.want)
+		}
+	}
+}
+
+func TestFindNfo(t *testing.T) {
+	tests := []struct {
+		files []string
+		want  string
+	}{
+		{files: []string{"tvshow.nfo", "movie.nfo"}, want: "tvshow.nfo"},
+		{files: []string{"a.nfo", "movie.nfo"}, want: "movie.nfo"},
+		{files: []string{"a.nfo", "Фильм (2000).nfo"}, want: "Фильм (2000).nfo"},
+		{files: []string{"b.nfo", "a.nfo"}, want: "a.nfo"},
+	}
+
+	for _, test := range tests {
+		dir := filepath.Join(t.TempDir(), "Фильм (2000)")
+		os.Mkdir(dir, 0o755)
+		for _, name := range test.files {
+			os.WriteFile(filepath.Join(dir, name), nil, 0o644)
+		}
+
+		got, err := FindNfo(dir)
+		if err != nil || got != filepath.Join(dir, test.want) {
+			t.Errorf("FindNfo(%q) = %q, %v, want %s", test.files, got, err, test.want)
+		}
+	}
+
+	_, err := FindNfo(t.TempDir())
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("FindNfo of a folder without nfo: %v", err)
+	}
+}
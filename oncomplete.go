@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/shadream/kftm/metainfo"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// Exit codes of on-complete, qbitorrent ignores them but they are useful for
+// wrapper scripts.
+const (
+	exitProcessed = 0
+	// processing failed, the torrent is tagged kftm:error
+	exitFailed = 1
+	// wrong arguments or config
+	exitUsage = 2
+	// qbitorrent or kinopoisk is not reachable
+	exitUnavailable = 3
+	// the torrent is not handled by kftm, nothing is done
+	exitSkipped = 4
+	// interrupted, the job can be continued by resume
+	exitInterrupted = 5
+)
+
+// onComplete is run by qbitorrent when a download finishes:
+//
+//	kftm on-complete --hash "%I" --category "%L"
+//
+// It processes the job of the torrent without asking anything and logs to
+// the file set in config, since qbitorrent shows no output.
+func onComplete(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("on-complete", flag.ContinueOnError)
+	hashArg := flags.String("hash", "", "torrent hash, %I in qbitorrent")
+	category := flags.String("category", "", "torrent category, %L in qbitorrent")
+	err := flags.Parse(args)
+	if err != nil {
+		os.Exit(exitUsage)
+	}
+
+	config, err := readConfig()
+	if err != nil {
+		log.Print(err)
+		os.Exit(exitUsage)
+	}
+
+	logFile, err := os.OpenFile(onCompleteLogPath(config), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		log.Print(err)
+		os.Exit(exitUsage)
+	}
+	defer logFile.Close()
+
+	// progress of processing is printed to stdout, nobody reads it when kftm
+	// is run by qbitorrent
+	log.SetOutput(logFile)
+	os.Stdout = logFile
+
+	code := runOnComplete(ctx, *hashArg, *category)
+	log.Printf("on-complete %s finished with code %d", *hashArg, code)
+
+	logFile.Close()
+	os.Exit(code)
+}
+
+func runOnComplete(ctx context.Context, hashArg, category string) int {
+	hash, err := metainfo.ParseHash(hashArg)
+	if err != nil {
+		log.Print(err)
+		return exitUsage
+	}
+
+	log.Printf("on-complete %s, category %q", hash, category)
+
+	p, err := newProcessor(ctx)
+	if err != nil {
+		log.Print(err)
+		return exitUnavailable
+	}
+	p.auto = true
+
+	job, ok := p.jobs.Get(hash)
+	if !ok {
+		job, ok, err = p.adoptHash(ctx, hash)
+		if err != nil {
+			log.Print(err)
+			return exitUnavailable
+		}
+	}
+	if !ok {
+		log.Printf("%s is not handled by kftm", hash)
+		return exitSkipped
+	}
+	if job.Finished() {
+		log.Printf("%s (%s) is already processed", job.Name, hash)
+		return exitProcessed
+	}
+
+	// jobs added before categories were saved with them
+	if job.Category == "" {
+		job.Category = category
+	}
+
+	err = p.process(ctx, job, nil)
+	switch {
+	case ctx.Err() != nil:
+		log.Print("interrupted, run \"kftm resume\" to continue")
+		return exitInterrupted
+	case err != nil:
+		log.Printf("%s (%s): %v", job.Name, hash, err)
+		return exitFailed
+	}
+
+	log.Printf("%s (%s) is processed", job.Name, hash)
+
+	return exitProcessed
+}
+
+// adoptHash creates a job for the torrent by its kinopoisk tag.
+func (p *processor) adoptHash(ctx context.Context, hash string) (Job, bool, error) {
+	torrent, err := p.tClient.GetTorrentContext(ctx, hash)
+	if errors.Is(err, qbitorrent.ErrNotFound) {
+		return Job{}, false, nil
+	}
+	if err != nil {
+		return Job{}, false, fmt.Errorf("get torrent: %w", err)
+	}
+
+	return p.adoptTorrent(ctx, *torrent)
+}
+
+func onCompleteLogPath(config *Config) string {
+	if config.OnComplete.LogFile != "" {
+		return config.OnComplete.LogFile
+	}
+
+	return filepath.Join(filepath.Dir(configPath), "kftm.log")
+}
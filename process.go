@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+const (
+	// how many seconds to wait for qbitorrent to rename files
+	renameAttempts = 30
+	// the movie folder is on disk right after renaming or moving, unless
+	// kftm sees another folder than qbitorrent writes to
+	movieDirTimeout = 5 * time.Minute
+)
+
+// processor renames torrent files and writes metadata for them, saving
+// progress of every job after each stage.
+type processor struct {
+	config  *Config
+	tClient *qbitorrent.Client
+	kClient *kinopoisk.Client
+	jobs    *JobStore
+	// chooses between releases and files
+	profile QualityProfile
+	// files are picked by the profile without asking the user
+	auto bool
+}
+
+func newProcessor(ctx context.Context) (*processor, error) {
+	config, err := readConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return newProcessorWithConfig(ctx, config, jobStorePath())
+}
+
+// newProcessorWithConfig connects to qbitorrent, prepares categories and tags
+// and opens the job store at jobsPath.
+func newProcessorWithConfig(ctx context.Context, config *Config, jobsPath string) (*processor, error) {
+	tClient, err := createQbitorrentClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ensureCategories(ctx, tClient, config.Qbitorrent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = createStateTags(ctx, tClient)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := config.Quality.Profile("")
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := OpenJobStore(jobsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &processor{
+		config:  config,
+		tClient: tClient,
+		kClient: createKinopoiskClient(config),
+		jobs:    jobs,
+		profile: profile,
+	}, nil
+}
+
+// withProfile returns a copy of the processor choosing files by the
+// profile, p keeps its own.
+func (p *processor) withProfile(profile QualityProfile) *processor {
+	copied := *p
+	copied.profile = profile
+	return &copied
+}
+
+// currentJob reads the job from disk before it is continued, on-complete
+// may have processed it since the jobs were read.
+func (p *processor) currentJob(hash string) (Job, bool, error) {
+	err := p.jobs.Reload()
+	if err != nil {
+		return Job{}, false, err
+	}
+
+	job, ok := p.jobs.Get(hash)
+	return job, ok, nil
+}
+
+// process runs remaining stages of the job. Movie is loaded from kinopoisk
+// when it is nil. Tags of the torrent follow the stages, a failed job is
+// tagged kftm:error.
+func (p *processor) process(ctx context.Context, job Job, movie *kinopoisk.Movie) error {
+	err := p.processStages(ctx, job, movie)
+	// interrupted and stalled jobs are continued by resume, they are not
+	// failed
+	if err != nil && ctx.Err() == nil && !errors.Is(err, ErrMetadataTimeout) {
+		p.markError(ctx, job.Hash)
+	}
+
+	return err
+}
+
+func (p *processor) processStages(ctx context.Context, job Job, movie *kinopoisk.Movie) error {
+	var err error
+	if movie == nil {
+		movie, err = p.kClient.GetByIdContext(ctx, job.KinopoiskID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// clears the error tag of the previous attempt
+	err = p.markStage(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	if job.Stage == StageAdded {
+		fmt.Println("getting files...")
+
+		err = p.renameFiles(ctx, job)
+		if err != nil {
+			return err
+		}
+
+		job.Stage = StageRenamed
+		err = p.jobs.Save(job)
+		if err != nil {
+			return err
+		}
+
+		// torrent may have been stalled before and continued by resume,
+		// kftm:stalled is replaced too
+		err = p.markStage(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	library := p.config.Qbitorrent.CategoryByName(job.Category).Library
+	if job.Stage == StageRenamed && library != nil {
+		// the library gets only complete movies, players and media servers
+		// scanning it would see partial files. Resume or on-complete moves
+		// the torrent when it is downloaded
+		torrent, err := p.tClient.GetTorrentContext(ctx, job.Hash)
+		if err != nil {
+			return err
+		}
+		if flatFloat(torrent.Progress) < 1 {
+			fmt.Println("the torrent is moved to the library when the download finishes, run \"kftm resume\" then")
+			return nil
+		}
+
+		err = p.moveToLibrary(ctx, job, *library)
+		if err != nil {
+			return err
+		}
+
+		job.Stage = StageMoved
+		err = p.jobs.Save(job)
+		if err != nil {
+			return err
+		}
+
+		err = p.markStage(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	if job.Stage == StageRenamed || job.Stage == StageMoved {
+		err = p.writeMetadata(ctx, job, *movie)
+		if err != nil {
+			return err
+		}
+
+		err = p.applySeedingPolicy(ctx, job)
+		if err != nil {
+			return err
+		}
+
+		job.Stage = StageFinished
+		err = p.jobs.Save(job)
+		if err != nil {
+			return err
+		}
+
+		err = p.markStage(ctx, job)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renameFiles waits for torrent metadata and renames the movie file to
+// "name/name.ext" and the torrent itself to "name".
+func (p *processor) renameFiles(ctx context.Context, job Job) error {
+	content, err := p.waitMetadata(ctx, job.Hash)
+	if err != nil {
+		return err
+	}
+
+	file, err := p.movieFile(ctx, job, content)
+	if err != nil {
+		return err
+	}
+
+	err = p.selectFiles(ctx, job.Hash, content, file)
+	if err != nil {
+		return err
+	}
+
+	// the release folder is renamed to the movie folder first, with samples
+	// and subtitles of multi-file torrents. qbitorrent renames in
+	// background, the file is waited for at its new path before it is
+	// renamed
+	moviePath := *file.Name
+	dir := path.Dir(moviePath)
+	if len(content) != 1 && dir != "." && dir != job.Name {
+		err = p.tClient.RenameFolderContext(ctx, qbitorrent.RenameTorrentFiles{
+			Hash:    job.Hash,
+			OldPath: dir,
+			NewPath: job.Name,
+		})
+		if errors.Is(err, qbitorrent.ErrConflict) {
+			return fmt.Errorf("rename folder %s to %s, name is already used in torrent: %w", dir, job.Name, err)
+		}
+		if err != nil {
+			return err
+		}
+
+		moviePath = job.Name + strings.TrimPrefix(moviePath, dir)
+		err = p.waitFile(ctx, job.Hash, moviePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	newPath := fmt.Sprintf("%s/%s%s", job.Name, job.Name, path.Ext(moviePath))
+	if moviePath != newPath {
+		err = p.tClient.RenameFileContext(ctx, qbitorrent.RenameTorrentFiles{
+			Hash:    job.Hash,
+			OldPath: moviePath,
+			NewPath: newPath,
+		})
+		if errors.Is(err, qbitorrent.ErrConflict) {
+			return fmt.Errorf("rename %s to %s, name is already used in torrent: %w", moviePath, newPath, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	// show the movie name in qbitorrent instead of the release name
+	return p.tClient.RenameTorrentContext(ctx, qbitorrent.TorrentsRenamePostFormdataBody{
+		Hash: job.Hash,
+		Name: job.Name,
+	})
+}
+
+// waitFile polls the file list until the file appears, qbitorrent renames
+// files in background.
+func (p *processor) waitFile(ctx context.Context, hash, name string) error {
+	for attempt := 0; ; attempt++ {
+		content, err := p.tClient.GetTorrentContentContext(ctx, hash)
+		if err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(content, func(file qbitorrent.TorrentsFiles) bool { return flat(file.Name) == name }) {
+			return nil
+		}
+
+		if attempt == renameAttempts {
+			return fmt.Errorf("file %s did not appear after renaming the folder", name)
+		}
+
+		err = sleep(ctx, time.Second)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// movieFile returns the file chosen when the torrent was added or asks the
+// user to pick one.
+func (p *processor) movieFile(ctx context.Context, job Job, content []qbitorrent.TorrentsFiles) (qbitorrent.TorrentsFiles, error) {
+	if job.FileIndex != nil {
+		file, ok := TakeOne(content, func(item qbitorrent.TorrentsFiles) bool {
+			return item.Index != nil && *item.Index == *job.FileIndex
+		})
+		if ok {
+			return file, nil
+		}
+	}
+
+	if len(content) == 1 {
+		return content[0], nil
+	}
+
+	return p.pickFile(ctx, content)
+}
+
+// writeMetadata waits until the movie folder appears on disk and writes nfo
+// and poster into it. It fails after movieDirTimeout, the folder is not
+// there when real_save_path does not match the save path in qbitorrent.
+func (p *processor) writeMetadata(ctx context.Context, job Job, movie kinopoisk.Movie) error {
+	movieDir := filepath.Join(p.realSavePath(job), job.Name)
+	start := time.Now()
+	for {
+		_, err := os.ReadDir(movieDir)
+		if err == nil {
+			break
+		}
+
+		if time.Since(start) > movieDirTimeout {
+			return fmt.Errorf("movie folder %s does not appear in %s, check real_save_path: %w", movieDir, movieDirTimeout, err)
+		}
+
+		err = sleep(ctx, 2*time.Second)
+		if err != nil {
+			return err
+		}
+	}
+
+	nfoPath := filepath.Join(movieDir, fmt.Sprintf("%s.nfo", job.Name))
+	err := WriteNfo(nfoPath, KinopoiskDtoToNfo(movie))
+	if err != nil {
+		return err
+	}
+
+	imagePath := filepath.Join(movieDir, "poster.jpg")
+
+	return downloadImage(ctx, movie.Poster.URL, imagePath)
+}
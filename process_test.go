@@ -0,0 +1,496 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/kinopoisk/kinopoisktest"
+	"github.com/shadream/kftm/qbitorrent"
+	"github.com/shadream/kftm/qbitorrent/qbittest"
+)
+
+const (
+	testMovieName = "Побег из Шоушенка (1994)"
+	testMagnet    = "0123456789abcdef0123456789abcdef01234567"
+)
+
+// newTestProcessor starts a fake qbitorrent writing files into a temporary
+// folder, which is the save path of the movies category, and a fake
+// kinopoisk with posters served locally, and connects a processor picking
+// files without the user to them.
+func newTestProcessor(t *testing.T, configure func(config *Config)) (*processor, *qbittest.Server) {
+	t.Helper()
+
+	srv := qbittest.NewServer()
+	srv.Username = "admin"
+	srv.Password = "secret"
+	srv.WriteFiles = true
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+	downloads := filepath.Join(dir, "downloads")
+	config := &Config{
+		Qbitorrent: QbitorrentConfig{
+			BaseUrl:      srv.URL,
+			Category:     "movies",
+			SavePath:     downloads,
+			RealSavePath: downloads,
+			Username:     srv.Username,
+			Password:     srv.Password,
+		},
+		KinopoiskToken:   kinopoisktest.DefaultToken,
+		KinopoiskBaseUrl: newKinopoiskServer(t).URL,
+	}
+	if configure != nil {
+		configure(config)
+	}
+
+	p, err := newProcessorWithConfig(context.Background(), config, filepath.Join(dir, "jobs.json"))
+	if err != nil {
+		t.Fatalf("create processor: %v", err)
+	}
+	p.auto = true
+
+	return p, srv
+}
+
+// newKinopoiskServer starts a fake kinopoisk with recorded movies, their
+// posters and backdrops are served by a local server.
+func newKinopoiskServer(t *testing.T) *kinopoisktest.Server {
+	t.Helper()
+
+	poster := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jpeg.Encode(w, image.NewRGBA(image.Rect(0, 0, 2, 3)), nil)
+	}))
+	t.Cleanup(poster.Close)
+
+	srv := kinopoisktest.NewServer()
+	t.Cleanup(srv.Close)
+
+	for _, id := range kinopoisktest.MovieIDs() {
+		var movie map[string]any
+		err := json.Unmarshal(kinopoisktest.Fixture(fmt.Sprintf("movie_%d.json", id)), &movie)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		movie["poster"] = map[string]string{"url": fmt.Sprintf("%s/%d.jpg", poster.URL, id)}
+		movie["backdrop"] = map[string]string{"url": fmt.Sprintf("%s/%d_backdrop.jpg", poster.URL, id)}
+		data, _ := json.Marshal(movie)
+
+		err = srv.AddMovie(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return srv
+}
+
+// testMovie returns the recorded "Побег из Шоушенка".
+func testMovie(t *testing.T, p *processor) *kinopoisk.Movie {
+	t.Helper()
+
+	movie, err := p.kClient.GetById(326)
+	if err != nil {
+		t.Fatalf("get movie: %v", err)
+	}
+
+	return movie
+}
+
+func writeTorrentFile(t *testing.T, data []byte) string {
+	t.Helper()
+
+	name := filepath.Join(t.TempDir(), "release.torrent")
+	err := os.WriteFile(name, data, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func fileNames(torrent qbittest.Torrent) []string {
+	return Select(torrent.Files, func(file qbittest.File) string { return file.Name })
+}
+
+func assertFinished(t *testing.T, p *processor, srv *qbittest.Server, hash, movieDir string) {
+	t.Helper()
+
+	job, ok := p.jobs.Get(hash)
+	if !ok || job.Stage != StageFinished {
+		t.Errorf("job %+v, want finished", job)
+	}
+
+	torrent, _ := srv.Torrent(hash)
+	if torrent.Name != testMovieName {
+		t.Errorf("torrent name %q, want %q", torrent.Name, testMovieName)
+	}
+	if !slices.Contains(torrent.Tags, tagMetadata) || !slices.Contains(torrent.Tags, "kp:326") {
+		t.Errorf("torrent tags %v", torrent.Tags)
+	}
+	if slices.ContainsFunc(torrent.Tags, func(tag string) bool { return tag != tagMetadata && slices.Contains(stateTags, tag) }) {
+		t.Errorf("stale state tags in %v", torrent.Tags)
+	}
+
+	for _, name := range []string{testMovieName + ".mkv", testMovieName + ".nfo", "poster.jpg"} {
+		_, err := os.Stat(filepath.Join(movieDir, name))
+		if err != nil {
+			t.Errorf("%s is not written: %v", name, err)
+		}
+	}
+}
+
+func TestAddAndProcessTorrentFile(t *testing.T) {
+	p, srv := newTestProcessor(t, func(config *Config) {
+		config.Files = FilesConfig{SkipUnwanted: true, Languages: []string{"rus"}}
+		config.Seeding = []SeedingPolicy{{Category: "movies", RatioLimit: makePointer(2.0)}}
+	})
+
+	release := "The.Shawshank.Redemption.1994.1080p"
+	source := writeTorrentFile(t, qbittest.TorrentFile(release,
+		qbittest.File{Name: release + "/Sample/sample.mkv", Size: 50 << 20},
+		qbittest.File{Name: release + "/" + release + ".mkv", Size: 8 << 30},
+		qbittest.File{Name: release + "/Subs/rus.srt", Size: 100 << 10},
+		qbittest.File{Name: release + "/Subs/eng.srt", Size: 100 << 10},
+	))
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+
+	assertFinished(t, p, srv, job.Hash, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+
+	torrent, _ := srv.Torrent(job.Hash)
+	want := []string{
+		testMovieName + "/Sample/sample.mkv",
+		testMovieName + "/" + testMovieName + ".mkv",
+		testMovieName + "/Subs/rus.srt",
+		testMovieName + "/Subs/eng.srt",
+	}
+	if !slices.Equal(fileNames(torrent), want) {
+		t.Errorf("files %v, want %v", fileNames(torrent), want)
+	}
+
+	priorities := Select(torrent.Files, func(file qbittest.File) int { return file.Priority })
+	if !slices.Equal(priorities, []int{0, 1, 1, 0}) {
+		t.Errorf("priorities %v, sample and english subtitles are skipped", priorities)
+	}
+
+	if torrent.Category != "movies" || !torrent.AutoTMM {
+		t.Errorf("torrent category %q, automatic mode %v", torrent.Category, torrent.AutoTMM)
+	}
+	if torrent.RatioLimit != 2 || torrent.SeedingTimeLimit != -2 {
+		t.Errorf("share limits %v and %v, want 2 and global", torrent.RatioLimit, torrent.SeedingTimeLimit)
+	}
+}
+
+func TestAddAndProcessSlowMagnet(t *testing.T) {
+	p, srv := newTestProcessor(t, nil)
+
+	release := "Shawshank.1994.720p"
+	srv.SetMagnetMetadata(testMagnet, 1500*time.Millisecond, release,
+		qbittest.File{Name: release + "/shawshank.mkv", Size: 4 << 30, Priority: 1},
+		qbittest.File{Name: release + "/shawshank.sample.mkv", Size: 30 << 20, Priority: 1},
+	)
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), qbittest.Magnet(testMagnet, release))
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if job.Hash != testMagnet {
+		t.Errorf("job hash %s, want %s", job.Hash, testMagnet)
+	}
+
+	assertFinished(t, p, srv, job.Hash, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+
+	torrent, _ := srv.Torrent(job.Hash)
+	want := []string{testMovieName + "/" + testMovieName + ".mkv", testMovieName + "/shawshank.sample.mkv"}
+	if !slices.Equal(fileNames(torrent), want) {
+		t.Errorf("files %v, want %v", fileNames(torrent), want)
+	}
+}
+
+func TestMetadataTimeoutTagsStalled(t *testing.T) {
+	p, srv := newTestProcessor(t, func(config *Config) {
+		config.Metadata.Timeout = Duration(time.Second)
+	})
+	movie := testMovie(t, p)
+
+	job, err := p.addAndProcess(context.Background(), movie, qbittest.Magnet(testMagnet, "dead"))
+	if !errors.Is(err, ErrMetadataTimeout) {
+		t.Fatalf("got %v, want ErrMetadataTimeout", err)
+	}
+
+	torrent, _ := srv.Torrent(job.Hash)
+	if !slices.Contains(torrent.Tags, tagStalled) || slices.Contains(torrent.Tags, tagError) {
+		t.Errorf("tags of stalled torrent %v", torrent.Tags)
+	}
+
+	saved, ok := p.jobs.Get(job.Hash)
+	if !ok || saved.Stage != StageAdded {
+		t.Fatalf("job %+v is not kept for resume", saved)
+	}
+
+	// metadata comes later and resume finishes the job
+	srv.UpdateTorrent(job.Hash, func(torrent *qbittest.Torrent) {
+		torrent.Files = []qbittest.File{{Name: "dead.mkv", Size: 1 << 30, Priority: 1}}
+		torrent.State = qbitorrent.TorrentInfoStateDownloading
+	})
+	os.MkdirAll(p.config.Qbitorrent.RealSavePath, 0o755)
+	os.WriteFile(filepath.Join(p.config.Qbitorrent.RealSavePath, "dead.mkv"), nil, 0o644)
+
+	err = p.process(context.Background(), saved, movie)
+	if err != nil {
+		t.Fatalf("resume: %v", err)
+	}
+
+	assertFinished(t, p, srv, job.Hash, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+}
+
+func TestMetadataTimeoutRemoves(t *testing.T) {
+	p, srv := newTestProcessor(t, func(config *Config) {
+		config.Metadata = MetadataConfig{Timeout: Duration(time.Second), OnTimeout: onTimeoutRemove}
+	})
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), qbittest.Magnet(testMagnet, "dead"))
+	if !errors.Is(err, ErrMetadataTimeout) {
+		t.Fatalf("got %v, want ErrMetadataTimeout", err)
+	}
+
+	if _, ok := srv.Torrent(job.Hash); ok {
+		t.Error("stalled torrent is not removed")
+	}
+	if _, ok := p.jobs.Get(job.Hash); ok {
+		t.Error("job of removed torrent is kept")
+	}
+}
+
+func TestProcessMovesToLibrary(t *testing.T) {
+	var library string
+	p, srv := newTestProcessor(t, func(config *Config) {
+		library = filepath.Join(filepath.Dir(config.Qbitorrent.SavePath), "library")
+		config.Qbitorrent.Library = &LibraryConfig{Category: "library", SavePath: library, RealSavePath: library}
+		config.Seeding = []SeedingPolicy{{Category: "movies", RatioLimit: makePointer(2.0)}}
+	})
+	srv.MoveDelay = 1500 * time.Millisecond
+
+	if got := srv.Categories()["library"]; got != library {
+		t.Fatalf("library category save path %q, want %q", got, library)
+	}
+
+	source := writeTorrentFile(t, qbittest.TorrentFile("shawshank.mkv", qbittest.File{Name: "shawshank.mkv", Size: 4 << 30}))
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+
+	// the downloading torrent stays in staging
+	job, _ = p.jobs.Get(job.Hash)
+	if torrent, _ := srv.Torrent(job.Hash); job.Stage != StageRenamed || torrent.Category != "movies" {
+		t.Fatalf("job stage %s, torrent category %q before the download finishes", job.Stage, torrent.Category)
+	}
+
+	srv.UpdateTorrent(job.Hash, func(torrent *qbittest.Torrent) {
+		torrent.Progress = 1
+		torrent.State = qbitorrent.TorrentInfoStateUploading
+	})
+
+	err = p.process(context.Background(), job, nil)
+	if err != nil {
+		t.Fatalf("process downloaded: %v", err)
+	}
+
+	assertFinished(t, p, srv, job.Hash, filepath.Join(library, testMovieName))
+
+	torrent, _ := srv.Torrent(job.Hash)
+	if torrent.Category != "library" || torrent.SavePath != library {
+		t.Errorf("torrent is in category %q at %s", torrent.Category, torrent.SavePath)
+	}
+	// the policy of the staging category matches the moved torrent
+	if torrent.RatioLimit != 2 {
+		t.Errorf("ratio limit %v, want 2", torrent.RatioLimit)
+	}
+
+	saved, _ := p.jobs.Get(job.Hash)
+	if saved.Category != "movies" {
+		t.Errorf("job category %q, jobs keep the staging category", saved.Category)
+	}
+
+	_, err = os.Stat(filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("movie folder is left in staging: %v", err)
+	}
+}
+
+func TestMoveToLibraryTimeout(t *testing.T) {
+	p, srv := newTestProcessor(t, func(config *Config) {
+		library := filepath.Join(filepath.Dir(config.Qbitorrent.SavePath), "library")
+		config.Qbitorrent.Library = &LibraryConfig{
+			Category:     "library",
+			SavePath:     library,
+			RealSavePath: library,
+			MoveTimeout:  Duration(time.Second),
+		}
+	})
+	srv.MoveDelay = time.Minute
+
+	source := writeTorrentFile(t, qbittest.TorrentFile("shawshank.mkv", qbittest.File{Name: "shawshank.mkv", Size: 4 << 30}))
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+
+	srv.UpdateTorrent(job.Hash, func(torrent *qbittest.Torrent) { torrent.Progress = 1 })
+	job, _ = p.jobs.Get(job.Hash)
+
+	err = p.process(context.Background(), job, nil)
+	if err == nil {
+		t.Fatal("process waits for the move forever")
+	}
+
+	// the move is retried by resume
+	job, _ = p.jobs.Get(job.Hash)
+	if job.Stage != StageRenamed {
+		t.Errorf("job stage %s after the timeout", job.Stage)
+	}
+}
+
+func TestProcessFailureTagsError(t *testing.T) {
+	p, srv := newTestProcessor(t, nil)
+	srv.FailNext("torrents/renameFile", http.StatusInternalServerError)
+
+	source := writeTorrentFile(t, qbittest.TorrentFile("shawshank.mkv", qbittest.File{Name: "shawshank.mkv", Size: 4 << 30}))
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if !errors.Is(err, qbitorrent.ErrBadResponse) {
+		t.Fatalf("got %v, want ErrBadResponse", err)
+	}
+
+	torrent, _ := srv.Torrent(job.Hash)
+	if !slices.Equal(torrent.Tags, []string{"kp:326", tagError}) {
+		t.Errorf("tags of failed torrent %v", torrent.Tags)
+	}
+
+	saved, _ := p.jobs.Get(job.Hash)
+	if saved.Stage != StageAdded {
+		t.Errorf("failed job stage %s, want added", saved.Stage)
+	}
+}
+
+func TestProcessSurvivesSessionExpiry(t *testing.T) {
+	p, srv := newTestProcessor(t, nil)
+	srv.SetMagnetMetadata(testMagnet, 0, "shawshank.mkv", qbittest.File{Name: "shawshank.mkv", Size: 4 << 30, Priority: 1})
+
+	job, err := p.addAndProcess(context.Background(), testMovie(t, p), qbittest.Magnet(testMagnet, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.ExpireSessions()
+	job.Stage = StageAdded
+
+	err = p.process(context.Background(), job, testMovie(t, p))
+	if err != nil {
+		t.Fatalf("process after session expired: %v", err)
+	}
+
+	if got := len(srv.RequestsTo("auth/login")); got != 2 {
+		t.Errorf("logged in %d times, want 2", got)
+	}
+}
+
+func TestAddTorrentAgainContinues(t *testing.T) {
+	p, srv := newTestProcessor(t, nil)
+	source := writeTorrentFile(t, qbittest.TorrentFile("shawshank.mkv", qbittest.File{Name: "shawshank.mkv", Size: 4 << 30}))
+
+	first, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// qbitorrent answers 409, the torrent is processed again
+	second, err := p.addAndProcess(context.Background(), testMovie(t, p), source)
+	if err != nil {
+		t.Fatalf("add again: %v", err)
+	}
+	if second.Hash != first.Hash {
+		t.Errorf("hashes %s and %s differ", first.Hash, second.Hash)
+	}
+
+	assertFinished(t, p, srv, second.Hash, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+}
+
+func TestResumeAdoptsTaggedTorrent(t *testing.T) {
+	p, srv := newTestProcessor(t, nil)
+
+	// added by an rss rule of the watchlist
+	srv.AddTorrent(qbittest.Torrent{
+		Hash:     testMagnet,
+		Name:     "Shawshank.1994.1080p.mkv",
+		Category: "movies",
+		Tags:     []string{"kp:326"},
+		SavePath: p.config.Qbitorrent.SavePath,
+		AutoTMM:  true,
+		State:    qbitorrent.TorrentInfoStateUploading,
+		Progress: 1,
+		Files:    []qbittest.File{{Name: "Shawshank.1994.1080p.mkv", Size: 8 << 30, Priority: 1}},
+	})
+	os.MkdirAll(p.config.Qbitorrent.RealSavePath, 0o755)
+	os.WriteFile(filepath.Join(p.config.Qbitorrent.RealSavePath, "Shawshank.1994.1080p.mkv"), nil, 0o644)
+
+	movie := testMovie(t, p)
+	other := *movie
+	other.ID = 327
+	for _, watched := range []kinopoisk.Movie{*movie, other} {
+		rule := movieRssRule(watched, QualityProfile{}, []string{"http://feed.example/rss"}, "movies")
+		if flatFloat(rule.IgnoreDays) == 0 {
+			t.Fatal("rss rule downloads every matching release")
+		}
+
+		err := p.tClient.SetRssRule(rssRuleName(watched), rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := p.adoptTagged(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the rule of the adopted movie is removed, the other one is left
+	rules := slices.Sorted(maps.Keys(srv.RssRules()))
+	if !slices.Equal(rules, []string{rssRuleName(other)}) {
+		t.Errorf("rss rules %q after adopting", rules)
+	}
+
+	jobs := p.jobs.Unfinished()
+	if len(jobs) != 1 || jobs[0].Name != testMovieName || jobs[0].KinopoiskID != 326 {
+		t.Fatalf("adopted jobs %+v", jobs)
+	}
+
+	// the movie is loaded from kinopoisk by the job
+	err = p.process(context.Background(), jobs[0], nil)
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+
+	assertFinished(t, p, srv, testMagnet, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+}
@@ -0,0 +1,11420 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// AppBuildInfoGet request
+	AppBuildInfoGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppDefaultSavePathGet request
+	AppDefaultSavePathGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppPreferencesGet request
+	AppPreferencesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppSetPreferencesPostWithBody request with any body
+	AppSetPreferencesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AppSetPreferencesPostWithFormdataBody(ctx context.Context, body AppSetPreferencesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppShutdownGet request
+	AppShutdownGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppVersionGet request
+	AppVersionGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AppWebapiVersionGet request
+	AppWebapiVersionGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthLoginPostWithBody request with any body
+	AuthLoginPostWithBody(ctx context.Context, params *AuthLoginPostParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AuthLoginPostWithFormdataBody(ctx context.Context, params *AuthLoginPostParams, body AuthLoginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AuthLogoutPost request
+	AuthLogoutPost(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogMainPostWithBody request with any body
+	LogMainPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LogMainPostWithFormdataBody(ctx context.Context, body LogMainPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogPeersPostWithBody request with any body
+	LogPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	LogPeersPostWithFormdataBody(ctx context.Context, body LogPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssAddFeedPostWithBody request with any body
+	RssAddFeedPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssAddFeedPostWithFormdataBody(ctx context.Context, body RssAddFeedPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssAddFolderPostWithBody request with any body
+	RssAddFolderPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssAddFolderPostWithFormdataBody(ctx context.Context, body RssAddFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssItemsPostWithBody request with any body
+	RssItemsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssItemsPostWithFormdataBody(ctx context.Context, body RssItemsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssMarkAsReadPostWithBody request with any body
+	RssMarkAsReadPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssMarkAsReadPostWithFormdataBody(ctx context.Context, body RssMarkAsReadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssMatchingArticlesPostWithBody request with any body
+	RssMatchingArticlesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssMatchingArticlesPostWithFormdataBody(ctx context.Context, body RssMatchingArticlesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssMoveItemPostWithBody request with any body
+	RssMoveItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssMoveItemPostWithFormdataBody(ctx context.Context, body RssMoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssRefreshItemPostWithBody request with any body
+	RssRefreshItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssRefreshItemPostWithFormdataBody(ctx context.Context, body RssRefreshItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssRemoveItemPostWithBody request with any body
+	RssRemoveItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssRemoveItemPostWithFormdataBody(ctx context.Context, body RssRemoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssRemoveRulePostWithBody request with any body
+	RssRemoveRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssRemoveRulePostWithFormdataBody(ctx context.Context, body RssRemoveRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssRenameRulePostWithBody request with any body
+	RssRenameRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssRenameRulePostWithFormdataBody(ctx context.Context, body RssRenameRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssRulesGet request
+	RssRulesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RssSetRulePostWithBody request with any body
+	RssSetRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RssSetRulePostWithFormdataBody(ctx context.Context, body RssSetRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchDeletePostWithBody request with any body
+	SearchDeletePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchDeletePostWithFormdataBody(ctx context.Context, body SearchDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchEnablePluginPostWithBody request with any body
+	SearchEnablePluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchEnablePluginPostWithFormdataBody(ctx context.Context, body SearchEnablePluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchInstallPluginPostWithBody request with any body
+	SearchInstallPluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchInstallPluginPostWithFormdataBody(ctx context.Context, body SearchInstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchPluginsGet request
+	SearchPluginsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchResultsPostWithBody request with any body
+	SearchResultsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchResultsPostWithFormdataBody(ctx context.Context, body SearchResultsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchStartPostWithBody request with any body
+	SearchStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchStartPostWithFormdataBody(ctx context.Context, body SearchStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchStatusPostWithBody request with any body
+	SearchStatusPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchStatusPostWithFormdataBody(ctx context.Context, body SearchStatusPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchStopPostWithBody request with any body
+	SearchStopPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchStopPostWithFormdataBody(ctx context.Context, body SearchStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchUninstallPluginPostWithBody request with any body
+	SearchUninstallPluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SearchUninstallPluginPostWithFormdataBody(ctx context.Context, body SearchUninstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchUpdatePluginsPost request
+	SearchUpdatePluginsPost(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncMaindataPostWithBody request with any body
+	SyncMaindataPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SyncMaindataPostWithFormdataBody(ctx context.Context, body SyncMaindataPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SyncTorrentPeersPostWithBody request with any body
+	SyncTorrentPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SyncTorrentPeersPostWithFormdataBody(ctx context.Context, body SyncTorrentPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsAddPostWithBody request with any body
+	TorrentsAddPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsAddPeersPostWithBody request with any body
+	TorrentsAddPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsAddPeersPostWithFormdataBody(ctx context.Context, body TorrentsAddPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsAddTagsPostWithBody request with any body
+	TorrentsAddTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsAddTagsPostWithFormdataBody(ctx context.Context, body TorrentsAddTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsAddTrackersPostWithBody request with any body
+	TorrentsAddTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsAddTrackersPostWithFormdataBody(ctx context.Context, body TorrentsAddTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsBottomPrioPostWithBody request with any body
+	TorrentsBottomPrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsBottomPrioPostWithFormdataBody(ctx context.Context, body TorrentsBottomPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsCategoriesGet request
+	TorrentsCategoriesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsCreateCategoryPostWithBody request with any body
+	TorrentsCreateCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsCreateCategoryPostWithFormdataBody(ctx context.Context, body TorrentsCreateCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsCreateTagsPostWithBody request with any body
+	TorrentsCreateTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsCreateTagsPostWithFormdataBody(ctx context.Context, body TorrentsCreateTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsDecreasePrioPostWithBody request with any body
+	TorrentsDecreasePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsDecreasePrioPostWithFormdataBody(ctx context.Context, body TorrentsDecreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsDeletePostWithBody request with any body
+	TorrentsDeletePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsDeletePostWithFormdataBody(ctx context.Context, body TorrentsDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsDeleteTagsPostWithBody request with any body
+	TorrentsDeleteTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsDeleteTagsPostWithFormdataBody(ctx context.Context, body TorrentsDeleteTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsDownloadLimitPostWithBody request with any body
+	TorrentsDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsDownloadLimitPostWithFormdataBody(ctx context.Context, body TorrentsDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsEditCategoryPostWithBody request with any body
+	TorrentsEditCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsEditCategoryPostWithFormdataBody(ctx context.Context, body TorrentsEditCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsEditTrackerPostWithBody request with any body
+	TorrentsEditTrackerPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsEditTrackerPostWithFormdataBody(ctx context.Context, body TorrentsEditTrackerPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsFilePrioPostWithBody request with any body
+	TorrentsFilePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsFilePrioPostWithFormdataBody(ctx context.Context, body TorrentsFilePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsFilesPostWithBody request with any body
+	TorrentsFilesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsFilesPostWithFormdataBody(ctx context.Context, body TorrentsFilesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsIncreasePrioPostWithBody request with any body
+	TorrentsIncreasePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsIncreasePrioPostWithFormdataBody(ctx context.Context, body TorrentsIncreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsInfoPostWithBody request with any body
+	TorrentsInfoPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsInfoPostWithFormdataBody(ctx context.Context, body TorrentsInfoPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsPausePostWithBody request with any body
+	TorrentsPausePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsPausePostWithFormdataBody(ctx context.Context, body TorrentsPausePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsPieceHashesPostWithBody request with any body
+	TorrentsPieceHashesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsPieceHashesPostWithFormdataBody(ctx context.Context, body TorrentsPieceHashesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsPieceStatesPostWithBody request with any body
+	TorrentsPieceStatesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsPieceStatesPostWithFormdataBody(ctx context.Context, body TorrentsPieceStatesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsPropertiesPostWithBody request with any body
+	TorrentsPropertiesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsPropertiesPostWithFormdataBody(ctx context.Context, body TorrentsPropertiesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsReannouncePostWithBody request with any body
+	TorrentsReannouncePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsReannouncePostWithFormdataBody(ctx context.Context, body TorrentsReannouncePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRecheckPostWithBody request with any body
+	TorrentsRecheckPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRecheckPostWithFormdataBody(ctx context.Context, body TorrentsRecheckPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRemoveCategoriesPostWithBody request with any body
+	TorrentsRemoveCategoriesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRemoveCategoriesPostWithFormdataBody(ctx context.Context, body TorrentsRemoveCategoriesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRemoveTagsPostWithBody request with any body
+	TorrentsRemoveTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRemoveTagsPostWithFormdataBody(ctx context.Context, body TorrentsRemoveTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRemoveTrackersPostWithBody request with any body
+	TorrentsRemoveTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRemoveTrackersPostWithFormdataBody(ctx context.Context, body TorrentsRemoveTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRenamePostWithBody request with any body
+	TorrentsRenamePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRenamePostWithFormdataBody(ctx context.Context, body TorrentsRenamePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRenameFilePostWithBody request with any body
+	TorrentsRenameFilePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRenameFilePostWithFormdataBody(ctx context.Context, body TorrentsRenameFilePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsRenameFolderPostWithBody request with any body
+	TorrentsRenameFolderPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsRenameFolderPostWithFormdataBody(ctx context.Context, body TorrentsRenameFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsResumePostWithBody request with any body
+	TorrentsResumePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsResumePostWithFormdataBody(ctx context.Context, body TorrentsResumePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetAutoManagementPostWithBody request with any body
+	TorrentsSetAutoManagementPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetAutoManagementPostWithFormdataBody(ctx context.Context, body TorrentsSetAutoManagementPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetCategoryPostWithBody request with any body
+	TorrentsSetCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetCategoryPostWithFormdataBody(ctx context.Context, body TorrentsSetCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetDownloadLimitPostWithBody request with any body
+	TorrentsSetDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetDownloadLimitPostWithFormdataBody(ctx context.Context, body TorrentsSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetForceStartPostWithBody request with any body
+	TorrentsSetForceStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetForceStartPostWithFormdataBody(ctx context.Context, body TorrentsSetForceStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetLocationPostWithBody request with any body
+	TorrentsSetLocationPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetLocationPostWithFormdataBody(ctx context.Context, body TorrentsSetLocationPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetShareLimitsPostWithBody request with any body
+	TorrentsSetShareLimitsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetShareLimitsPostWithFormdataBody(ctx context.Context, body TorrentsSetShareLimitsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetSuperSeedingPostWithBody request with any body
+	TorrentsSetSuperSeedingPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetSuperSeedingPostWithFormdataBody(ctx context.Context, body TorrentsSetSuperSeedingPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsSetUploadLimitPostWithBody request with any body
+	TorrentsSetUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsSetUploadLimitPostWithFormdataBody(ctx context.Context, body TorrentsSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsStartPostWithBody request with any body
+	TorrentsStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsStartPostWithFormdataBody(ctx context.Context, body TorrentsStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsStopPostWithBody request with any body
+	TorrentsStopPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsStopPostWithFormdataBody(ctx context.Context, body TorrentsStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsTagsGet request
+	TorrentsTagsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsToggleFirstLastPiecePrioPostWithBody request with any body
+	TorrentsToggleFirstLastPiecePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsToggleFirstLastPiecePrioPostWithFormdataBody(ctx context.Context, body TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsToggleSequentialDownloadPostWithBody request with any body
+	TorrentsToggleSequentialDownloadPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsToggleSequentialDownloadPostWithFormdataBody(ctx context.Context, body TorrentsToggleSequentialDownloadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsTopPrioPostWithBody request with any body
+	TorrentsTopPrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsTopPrioPostWithFormdataBody(ctx context.Context, body TorrentsTopPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsTrackersPostWithBody request with any body
+	TorrentsTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsTrackersPostWithFormdataBody(ctx context.Context, body TorrentsTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentsUploadLimitPostWithBody request with any body
+	TorrentsUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentsUploadLimitPostWithFormdataBody(ctx context.Context, body TorrentsUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TorrentWebseedsPostWithBody request with any body
+	TorrentWebseedsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TorrentWebseedsPostWithFormdataBody(ctx context.Context, body TorrentWebseedsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferBanPeersPostWithBody request with any body
+	TransferBanPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferBanPeersPostWithFormdataBody(ctx context.Context, body TransferBanPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferDownloadLimitGet request
+	TransferDownloadLimitGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferInfoGet request
+	TransferInfoGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferSetDownloadLimitPostWithBody request with any body
+	TransferSetDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferSetDownloadLimitPostWithFormdataBody(ctx context.Context, body TransferSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferSetUploadLimitPostWithBody request with any body
+	TransferSetUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransferSetUploadLimitPostWithFormdataBody(ctx context.Context, body TransferSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferSpeedLimitsModeGet request
+	TransferSpeedLimitsModeGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferToggleSpeedLimitsModeGet request
+	TransferToggleSpeedLimitsModeGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransferUploadLimitGet request
+	TransferUploadLimitGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) AppBuildInfoGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppBuildInfoGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppDefaultSavePathGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppDefaultSavePathGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppPreferencesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppPreferencesGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppSetPreferencesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSetPreferencesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppSetPreferencesPostWithFormdataBody(ctx context.Context, body AppSetPreferencesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppSetPreferencesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppShutdownGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppShutdownGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppVersionGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppVersionGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AppWebapiVersionGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAppWebapiVersionGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthLoginPostWithBody(ctx context.Context, params *AuthLoginPostParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginPostRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthLoginPostWithFormdataBody(ctx context.Context, params *AuthLoginPostParams, body AuthLoginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLoginPostRequestWithFormdataBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AuthLogoutPost(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAuthLogoutPostRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogMainPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogMainPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogMainPostWithFormdataBody(ctx context.Context, body LogMainPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogMainPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogPeersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) LogPeersPostWithFormdataBody(ctx context.Context, body LogPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogPeersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssAddFeedPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssAddFeedPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssAddFeedPostWithFormdataBody(ctx context.Context, body RssAddFeedPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssAddFeedPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssAddFolderPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssAddFolderPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssAddFolderPostWithFormdataBody(ctx context.Context, body RssAddFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssAddFolderPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssItemsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssItemsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssItemsPostWithFormdataBody(ctx context.Context, body RssItemsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssItemsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMarkAsReadPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMarkAsReadPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMarkAsReadPostWithFormdataBody(ctx context.Context, body RssMarkAsReadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMarkAsReadPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMatchingArticlesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMatchingArticlesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMatchingArticlesPostWithFormdataBody(ctx context.Context, body RssMatchingArticlesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMatchingArticlesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMoveItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMoveItemPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssMoveItemPostWithFormdataBody(ctx context.Context, body RssMoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssMoveItemPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRefreshItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRefreshItemPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRefreshItemPostWithFormdataBody(ctx context.Context, body RssRefreshItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRefreshItemPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRemoveItemPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRemoveItemPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRemoveItemPostWithFormdataBody(ctx context.Context, body RssRemoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRemoveItemPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRemoveRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRemoveRulePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRemoveRulePostWithFormdataBody(ctx context.Context, body RssRemoveRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRemoveRulePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRenameRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRenameRulePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRenameRulePostWithFormdataBody(ctx context.Context, body RssRenameRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRenameRulePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssRulesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssRulesGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssSetRulePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssSetRulePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RssSetRulePostWithFormdataBody(ctx context.Context, body RssSetRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRssSetRulePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchDeletePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDeletePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchDeletePostWithFormdataBody(ctx context.Context, body SearchDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDeletePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchEnablePluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchEnablePluginPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchEnablePluginPostWithFormdataBody(ctx context.Context, body SearchEnablePluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchEnablePluginPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchInstallPluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchInstallPluginPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchInstallPluginPostWithFormdataBody(ctx context.Context, body SearchInstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchInstallPluginPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchPluginsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchPluginsGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchResultsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchResultsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchResultsPostWithFormdataBody(ctx context.Context, body SearchResultsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchResultsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStartPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStartPostWithFormdataBody(ctx context.Context, body SearchStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStartPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStatusPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStatusPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStatusPostWithFormdataBody(ctx context.Context, body SearchStatusPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStatusPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStopPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStopPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchStopPostWithFormdataBody(ctx context.Context, body SearchStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchStopPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchUninstallPluginPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchUninstallPluginPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchUninstallPluginPostWithFormdataBody(ctx context.Context, body SearchUninstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchUninstallPluginPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchUpdatePluginsPost(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchUpdatePluginsPostRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncMaindataPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncMaindataPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncMaindataPostWithFormdataBody(ctx context.Context, body SyncMaindataPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncMaindataPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncTorrentPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncTorrentPeersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SyncTorrentPeersPostWithFormdataBody(ctx context.Context, body SyncTorrentPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSyncTorrentPeersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddPeersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddPeersPostWithFormdataBody(ctx context.Context, body TorrentsAddPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddPeersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddTagsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddTagsPostWithFormdataBody(ctx context.Context, body TorrentsAddTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddTagsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddTrackersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsAddTrackersPostWithFormdataBody(ctx context.Context, body TorrentsAddTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsAddTrackersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsBottomPrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsBottomPrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsBottomPrioPostWithFormdataBody(ctx context.Context, body TorrentsBottomPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsBottomPrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsCategoriesGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsCategoriesGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsCreateCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsCreateCategoryPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsCreateCategoryPostWithFormdataBody(ctx context.Context, body TorrentsCreateCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsCreateCategoryPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsCreateTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsCreateTagsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsCreateTagsPostWithFormdataBody(ctx context.Context, body TorrentsCreateTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsCreateTagsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDecreasePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDecreasePrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDecreasePrioPostWithFormdataBody(ctx context.Context, body TorrentsDecreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDecreasePrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDeletePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDeletePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDeletePostWithFormdataBody(ctx context.Context, body TorrentsDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDeletePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDeleteTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDeleteTagsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDeleteTagsPostWithFormdataBody(ctx context.Context, body TorrentsDeleteTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDeleteTagsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDownloadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsDownloadLimitPostWithFormdataBody(ctx context.Context, body TorrentsDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsDownloadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsEditCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsEditCategoryPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsEditCategoryPostWithFormdataBody(ctx context.Context, body TorrentsEditCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsEditCategoryPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsEditTrackerPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsEditTrackerPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsEditTrackerPostWithFormdataBody(ctx context.Context, body TorrentsEditTrackerPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsEditTrackerPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsFilePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsFilePrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsFilePrioPostWithFormdataBody(ctx context.Context, body TorrentsFilePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsFilePrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsFilesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsFilesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsFilesPostWithFormdataBody(ctx context.Context, body TorrentsFilesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsFilesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsIncreasePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsIncreasePrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsIncreasePrioPostWithFormdataBody(ctx context.Context, body TorrentsIncreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsIncreasePrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsInfoPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsInfoPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsInfoPostWithFormdataBody(ctx context.Context, body TorrentsInfoPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsInfoPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPausePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPausePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPausePostWithFormdataBody(ctx context.Context, body TorrentsPausePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPausePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPieceHashesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPieceHashesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPieceHashesPostWithFormdataBody(ctx context.Context, body TorrentsPieceHashesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPieceHashesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPieceStatesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPieceStatesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPieceStatesPostWithFormdataBody(ctx context.Context, body TorrentsPieceStatesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPieceStatesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPropertiesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPropertiesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsPropertiesPostWithFormdataBody(ctx context.Context, body TorrentsPropertiesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsPropertiesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsReannouncePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsReannouncePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsReannouncePostWithFormdataBody(ctx context.Context, body TorrentsReannouncePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsReannouncePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRecheckPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRecheckPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRecheckPostWithFormdataBody(ctx context.Context, body TorrentsRecheckPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRecheckPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveCategoriesPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveCategoriesPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveCategoriesPostWithFormdataBody(ctx context.Context, body TorrentsRemoveCategoriesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveCategoriesPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveTagsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveTagsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveTagsPostWithFormdataBody(ctx context.Context, body TorrentsRemoveTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveTagsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveTrackersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRemoveTrackersPostWithFormdataBody(ctx context.Context, body TorrentsRemoveTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRemoveTrackersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenamePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenamePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenamePostWithFormdataBody(ctx context.Context, body TorrentsRenamePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenamePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenameFilePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenameFilePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenameFilePostWithFormdataBody(ctx context.Context, body TorrentsRenameFilePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenameFilePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenameFolderPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenameFolderPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsRenameFolderPostWithFormdataBody(ctx context.Context, body TorrentsRenameFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsRenameFolderPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsResumePostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsResumePostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsResumePostWithFormdataBody(ctx context.Context, body TorrentsResumePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsResumePostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetAutoManagementPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetAutoManagementPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetAutoManagementPostWithFormdataBody(ctx context.Context, body TorrentsSetAutoManagementPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetAutoManagementPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetCategoryPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetCategoryPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetCategoryPostWithFormdataBody(ctx context.Context, body TorrentsSetCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetCategoryPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetDownloadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetDownloadLimitPostWithFormdataBody(ctx context.Context, body TorrentsSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetDownloadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetForceStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetForceStartPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetForceStartPostWithFormdataBody(ctx context.Context, body TorrentsSetForceStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetForceStartPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetLocationPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetLocationPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetLocationPostWithFormdataBody(ctx context.Context, body TorrentsSetLocationPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetLocationPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetShareLimitsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetShareLimitsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetShareLimitsPostWithFormdataBody(ctx context.Context, body TorrentsSetShareLimitsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetShareLimitsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetSuperSeedingPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetSuperSeedingPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetSuperSeedingPostWithFormdataBody(ctx context.Context, body TorrentsSetSuperSeedingPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetSuperSeedingPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetUploadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsSetUploadLimitPostWithFormdataBody(ctx context.Context, body TorrentsSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsSetUploadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsStartPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsStartPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsStartPostWithFormdataBody(ctx context.Context, body TorrentsStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsStartPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsStopPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsStopPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsStopPostWithFormdataBody(ctx context.Context, body TorrentsStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsStopPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsTagsGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsTagsGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsToggleFirstLastPiecePrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsToggleFirstLastPiecePrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsToggleFirstLastPiecePrioPostWithFormdataBody(ctx context.Context, body TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsToggleFirstLastPiecePrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsToggleSequentialDownloadPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsToggleSequentialDownloadPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsToggleSequentialDownloadPostWithFormdataBody(ctx context.Context, body TorrentsToggleSequentialDownloadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsToggleSequentialDownloadPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsTopPrioPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsTopPrioPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsTopPrioPostWithFormdataBody(ctx context.Context, body TorrentsTopPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsTopPrioPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsTrackersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsTrackersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsTrackersPostWithFormdataBody(ctx context.Context, body TorrentsTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsTrackersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsUploadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentsUploadLimitPostWithFormdataBody(ctx context.Context, body TorrentsUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentsUploadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentWebseedsPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentWebseedsPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TorrentWebseedsPostWithFormdataBody(ctx context.Context, body TorrentWebseedsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTorrentWebseedsPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferBanPeersPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferBanPeersPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferBanPeersPostWithFormdataBody(ctx context.Context, body TransferBanPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferBanPeersPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferDownloadLimitGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferDownloadLimitGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferInfoGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferInfoGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferSetDownloadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferSetDownloadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferSetDownloadLimitPostWithFormdataBody(ctx context.Context, body TransferSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferSetDownloadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferSetUploadLimitPostWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferSetUploadLimitPostRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferSetUploadLimitPostWithFormdataBody(ctx context.Context, body TransferSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferSetUploadLimitPostRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferSpeedLimitsModeGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferSpeedLimitsModeGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferToggleSpeedLimitsModeGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferToggleSpeedLimitsModeGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransferUploadLimitGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransferUploadLimitGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewAppBuildInfoGetRequest generates requests for AppBuildInfoGet
+func NewAppBuildInfoGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/buildInfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppDefaultSavePathGetRequest generates requests for AppDefaultSavePathGet
+func NewAppDefaultSavePathGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/defaultSavePath")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppPreferencesGetRequest generates requests for AppPreferencesGet
+func NewAppPreferencesGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppSetPreferencesPostRequestWithFormdataBody calls the generic AppSetPreferencesPost builder with application/x-www-form-urlencoded body
+func NewAppSetPreferencesPostRequestWithFormdataBody(server string, body AppSetPreferencesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewAppSetPreferencesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewAppSetPreferencesPostRequestWithBody generates requests for AppSetPreferencesPost with any type of body
+func NewAppSetPreferencesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/setPreferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAppShutdownGetRequest generates requests for AppShutdownGet
+func NewAppShutdownGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/shutdown")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppVersionGetRequest generates requests for AppVersionGet
+func NewAppVersionGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAppWebapiVersionGetRequest generates requests for AppWebapiVersionGet
+func NewAppWebapiVersionGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/app/webapiVersion")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAuthLoginPostRequestWithFormdataBody calls the generic AuthLoginPost builder with application/x-www-form-urlencoded body
+func NewAuthLoginPostRequestWithFormdataBody(server string, params *AuthLoginPostParams, body AuthLoginPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewAuthLoginPostRequestWithBody(server, params, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewAuthLoginPostRequestWithBody generates requests for AuthLoginPost with any type of body
+func NewAuthLoginPostRequestWithBody(server string, params *AuthLoginPostParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.Referer != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Referer", runtime.ParamLocationHeader, *params.Referer)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Referer", headerParam0)
+		}
+
+		if params.Origin != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Origin", runtime.ParamLocationHeader, *params.Origin)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Origin", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAuthLogoutPostRequest generates requests for AuthLogoutPost
+func NewAuthLogoutPostRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewLogMainPostRequestWithFormdataBody calls the generic LogMainPost builder with application/x-www-form-urlencoded body
+func NewLogMainPostRequestWithFormdataBody(server string, body LogMainPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewLogMainPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewLogMainPostRequestWithBody generates requests for LogMainPost with any type of body
+func NewLogMainPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/log/main")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewLogPeersPostRequestWithFormdataBody calls the generic LogPeersPost builder with application/x-www-form-urlencoded body
+func NewLogPeersPostRequestWithFormdataBody(server string, body LogPeersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewLogPeersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewLogPeersPostRequestWithBody generates requests for LogPeersPost with any type of body
+func NewLogPeersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/log/peers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssAddFeedPostRequestWithFormdataBody calls the generic RssAddFeedPost builder with application/x-www-form-urlencoded body
+func NewRssAddFeedPostRequestWithFormdataBody(server string, body RssAddFeedPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssAddFeedPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssAddFeedPostRequestWithBody generates requests for RssAddFeedPost with any type of body
+func NewRssAddFeedPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/addFeed")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssAddFolderPostRequestWithFormdataBody calls the generic RssAddFolderPost builder with application/x-www-form-urlencoded body
+func NewRssAddFolderPostRequestWithFormdataBody(server string, body RssAddFolderPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssAddFolderPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssAddFolderPostRequestWithBody generates requests for RssAddFolderPost with any type of body
+func NewRssAddFolderPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/addFolder")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssItemsPostRequestWithFormdataBody calls the generic RssItemsPost builder with application/x-www-form-urlencoded body
+func NewRssItemsPostRequestWithFormdataBody(server string, body RssItemsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssItemsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssItemsPostRequestWithBody generates requests for RssItemsPost with any type of body
+func NewRssItemsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssMarkAsReadPostRequestWithFormdataBody calls the generic RssMarkAsReadPost builder with application/x-www-form-urlencoded body
+func NewRssMarkAsReadPostRequestWithFormdataBody(server string, body RssMarkAsReadPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssMarkAsReadPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssMarkAsReadPostRequestWithBody generates requests for RssMarkAsReadPost with any type of body
+func NewRssMarkAsReadPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/markAsRead")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssMatchingArticlesPostRequestWithFormdataBody calls the generic RssMatchingArticlesPost builder with application/x-www-form-urlencoded body
+func NewRssMatchingArticlesPostRequestWithFormdataBody(server string, body RssMatchingArticlesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssMatchingArticlesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssMatchingArticlesPostRequestWithBody generates requests for RssMatchingArticlesPost with any type of body
+func NewRssMatchingArticlesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/matchingArticles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssMoveItemPostRequestWithFormdataBody calls the generic RssMoveItemPost builder with application/x-www-form-urlencoded body
+func NewRssMoveItemPostRequestWithFormdataBody(server string, body RssMoveItemPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssMoveItemPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssMoveItemPostRequestWithBody generates requests for RssMoveItemPost with any type of body
+func NewRssMoveItemPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/moveItem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssRefreshItemPostRequestWithFormdataBody calls the generic RssRefreshItemPost builder with application/x-www-form-urlencoded body
+func NewRssRefreshItemPostRequestWithFormdataBody(server string, body RssRefreshItemPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssRefreshItemPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssRefreshItemPostRequestWithBody generates requests for RssRefreshItemPost with any type of body
+func NewRssRefreshItemPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/refreshItem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssRemoveItemPostRequestWithFormdataBody calls the generic RssRemoveItemPost builder with application/x-www-form-urlencoded body
+func NewRssRemoveItemPostRequestWithFormdataBody(server string, body RssRemoveItemPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssRemoveItemPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssRemoveItemPostRequestWithBody generates requests for RssRemoveItemPost with any type of body
+func NewRssRemoveItemPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/removeItem")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssRemoveRulePostRequestWithFormdataBody calls the generic RssRemoveRulePost builder with application/x-www-form-urlencoded body
+func NewRssRemoveRulePostRequestWithFormdataBody(server string, body RssRemoveRulePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssRemoveRulePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssRemoveRulePostRequestWithBody generates requests for RssRemoveRulePost with any type of body
+func NewRssRemoveRulePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/removeRule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssRenameRulePostRequestWithFormdataBody calls the generic RssRenameRulePost builder with application/x-www-form-urlencoded body
+func NewRssRenameRulePostRequestWithFormdataBody(server string, body RssRenameRulePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssRenameRulePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssRenameRulePostRequestWithBody generates requests for RssRenameRulePost with any type of body
+func NewRssRenameRulePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/renameRule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRssRulesGetRequest generates requests for RssRulesGet
+func NewRssRulesGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRssSetRulePostRequestWithFormdataBody calls the generic RssSetRulePost builder with application/x-www-form-urlencoded body
+func NewRssSetRulePostRequestWithFormdataBody(server string, body RssSetRulePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewRssSetRulePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewRssSetRulePostRequestWithBody generates requests for RssSetRulePost with any type of body
+func NewRssSetRulePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rss/setRule")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchDeletePostRequestWithFormdataBody calls the generic SearchDeletePost builder with application/x-www-form-urlencoded body
+func NewSearchDeletePostRequestWithFormdataBody(server string, body SearchDeletePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchDeletePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchDeletePostRequestWithBody generates requests for SearchDeletePost with any type of body
+func NewSearchDeletePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchEnablePluginPostRequestWithFormdataBody calls the generic SearchEnablePluginPost builder with application/x-www-form-urlencoded body
+func NewSearchEnablePluginPostRequestWithFormdataBody(server string, body SearchEnablePluginPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchEnablePluginPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchEnablePluginPostRequestWithBody generates requests for SearchEnablePluginPost with any type of body
+func NewSearchEnablePluginPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/enablePlugin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchInstallPluginPostRequestWithFormdataBody calls the generic SearchInstallPluginPost builder with application/x-www-form-urlencoded body
+func NewSearchInstallPluginPostRequestWithFormdataBody(server string, body SearchInstallPluginPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchInstallPluginPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchInstallPluginPostRequestWithBody generates requests for SearchInstallPluginPost with any type of body
+func NewSearchInstallPluginPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/installPlugin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchPluginsGetRequest generates requests for SearchPluginsGet
+func NewSearchPluginsGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/plugins")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchResultsPostRequestWithFormdataBody calls the generic SearchResultsPost builder with application/x-www-form-urlencoded body
+func NewSearchResultsPostRequestWithFormdataBody(server string, body SearchResultsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchResultsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchResultsPostRequestWithBody generates requests for SearchResultsPost with any type of body
+func NewSearchResultsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/results")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchStartPostRequestWithFormdataBody calls the generic SearchStartPost builder with application/x-www-form-urlencoded body
+func NewSearchStartPostRequestWithFormdataBody(server string, body SearchStartPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchStartPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchStartPostRequestWithBody generates requests for SearchStartPost with any type of body
+func NewSearchStartPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchStatusPostRequestWithFormdataBody calls the generic SearchStatusPost builder with application/x-www-form-urlencoded body
+func NewSearchStatusPostRequestWithFormdataBody(server string, body SearchStatusPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchStatusPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchStatusPostRequestWithBody generates requests for SearchStatusPost with any type of body
+func NewSearchStatusPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchStopPostRequestWithFormdataBody calls the generic SearchStopPost builder with application/x-www-form-urlencoded body
+func NewSearchStopPostRequestWithFormdataBody(server string, body SearchStopPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchStopPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchStopPostRequestWithBody generates requests for SearchStopPost with any type of body
+func NewSearchStopPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/stop")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchUninstallPluginPostRequestWithFormdataBody calls the generic SearchUninstallPluginPost builder with application/x-www-form-urlencoded body
+func NewSearchUninstallPluginPostRequestWithFormdataBody(server string, body SearchUninstallPluginPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSearchUninstallPluginPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSearchUninstallPluginPostRequestWithBody generates requests for SearchUninstallPluginPost with any type of body
+func NewSearchUninstallPluginPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/uninstallPlugin")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchUpdatePluginsPostRequest generates requests for SearchUpdatePluginsPost
+func NewSearchUpdatePluginsPostRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search/updatePlugins")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSyncMaindataPostRequestWithFormdataBody calls the generic SyncMaindataPost builder with application/x-www-form-urlencoded body
+func NewSyncMaindataPostRequestWithFormdataBody(server string, body SyncMaindataPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSyncMaindataPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSyncMaindataPostRequestWithBody generates requests for SyncMaindataPost with any type of body
+func NewSyncMaindataPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/maindata")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSyncTorrentPeersPostRequestWithFormdataBody calls the generic SyncTorrentPeersPost builder with application/x-www-form-urlencoded body
+func NewSyncTorrentPeersPostRequestWithFormdataBody(server string, body SyncTorrentPeersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewSyncTorrentPeersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewSyncTorrentPeersPostRequestWithBody generates requests for SyncTorrentPeersPost with any type of body
+func NewSyncTorrentPeersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sync/torrentPeers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsAddPostRequestWithBody generates requests for TorrentsAddPost with any type of body
+func NewTorrentsAddPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsAddPeersPostRequestWithFormdataBody calls the generic TorrentsAddPeersPost builder with application/x-www-form-urlencoded body
+func NewTorrentsAddPeersPostRequestWithFormdataBody(server string, body TorrentsAddPeersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsAddPeersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsAddPeersPostRequestWithBody generates requests for TorrentsAddPeersPost with any type of body
+func NewTorrentsAddPeersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/addPeers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsAddTagsPostRequestWithFormdataBody calls the generic TorrentsAddTagsPost builder with application/x-www-form-urlencoded body
+func NewTorrentsAddTagsPostRequestWithFormdataBody(server string, body TorrentsAddTagsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsAddTagsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsAddTagsPostRequestWithBody generates requests for TorrentsAddTagsPost with any type of body
+func NewTorrentsAddTagsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/addTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsAddTrackersPostRequestWithFormdataBody calls the generic TorrentsAddTrackersPost builder with application/x-www-form-urlencoded body
+func NewTorrentsAddTrackersPostRequestWithFormdataBody(server string, body TorrentsAddTrackersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsAddTrackersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsAddTrackersPostRequestWithBody generates requests for TorrentsAddTrackersPost with any type of body
+func NewTorrentsAddTrackersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/addTrackers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsBottomPrioPostRequestWithFormdataBody calls the generic TorrentsBottomPrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsBottomPrioPostRequestWithFormdataBody(server string, body TorrentsBottomPrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsBottomPrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsBottomPrioPostRequestWithBody generates requests for TorrentsBottomPrioPost with any type of body
+func NewTorrentsBottomPrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/bottomPrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsCategoriesGetRequest generates requests for TorrentsCategoriesGet
+func NewTorrentsCategoriesGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/categories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTorrentsCreateCategoryPostRequestWithFormdataBody calls the generic TorrentsCreateCategoryPost builder with application/x-www-form-urlencoded body
+func NewTorrentsCreateCategoryPostRequestWithFormdataBody(server string, body TorrentsCreateCategoryPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsCreateCategoryPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsCreateCategoryPostRequestWithBody generates requests for TorrentsCreateCategoryPost with any type of body
+func NewTorrentsCreateCategoryPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/createCategory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsCreateTagsPostRequestWithFormdataBody calls the generic TorrentsCreateTagsPost builder with application/x-www-form-urlencoded body
+func NewTorrentsCreateTagsPostRequestWithFormdataBody(server string, body TorrentsCreateTagsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsCreateTagsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsCreateTagsPostRequestWithBody generates requests for TorrentsCreateTagsPost with any type of body
+func NewTorrentsCreateTagsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/createTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsDecreasePrioPostRequestWithFormdataBody calls the generic TorrentsDecreasePrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsDecreasePrioPostRequestWithFormdataBody(server string, body TorrentsDecreasePrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsDecreasePrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsDecreasePrioPostRequestWithBody generates requests for TorrentsDecreasePrioPost with any type of body
+func NewTorrentsDecreasePrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/decreasePrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsDeletePostRequestWithFormdataBody calls the generic TorrentsDeletePost builder with application/x-www-form-urlencoded body
+func NewTorrentsDeletePostRequestWithFormdataBody(server string, body TorrentsDeletePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsDeletePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsDeletePostRequestWithBody generates requests for TorrentsDeletePost with any type of body
+func NewTorrentsDeletePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsDeleteTagsPostRequestWithFormdataBody calls the generic TorrentsDeleteTagsPost builder with application/x-www-form-urlencoded body
+func NewTorrentsDeleteTagsPostRequestWithFormdataBody(server string, body TorrentsDeleteTagsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsDeleteTagsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsDeleteTagsPostRequestWithBody generates requests for TorrentsDeleteTagsPost with any type of body
+func NewTorrentsDeleteTagsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/deleteTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsDownloadLimitPostRequestWithFormdataBody calls the generic TorrentsDownloadLimitPost builder with application/x-www-form-urlencoded body
+func NewTorrentsDownloadLimitPostRequestWithFormdataBody(server string, body TorrentsDownloadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsDownloadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsDownloadLimitPostRequestWithBody generates requests for TorrentsDownloadLimitPost with any type of body
+func NewTorrentsDownloadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/downloadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsEditCategoryPostRequestWithFormdataBody calls the generic TorrentsEditCategoryPost builder with application/x-www-form-urlencoded body
+func NewTorrentsEditCategoryPostRequestWithFormdataBody(server string, body TorrentsEditCategoryPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsEditCategoryPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsEditCategoryPostRequestWithBody generates requests for TorrentsEditCategoryPost with any type of body
+func NewTorrentsEditCategoryPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/editCategory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsEditTrackerPostRequestWithFormdataBody calls the generic TorrentsEditTrackerPost builder with application/x-www-form-urlencoded body
+func NewTorrentsEditTrackerPostRequestWithFormdataBody(server string, body TorrentsEditTrackerPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsEditTrackerPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsEditTrackerPostRequestWithBody generates requests for TorrentsEditTrackerPost with any type of body
+func NewTorrentsEditTrackerPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/editTracker")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsFilePrioPostRequestWithFormdataBody calls the generic TorrentsFilePrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsFilePrioPostRequestWithFormdataBody(server string, body TorrentsFilePrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsFilePrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsFilePrioPostRequestWithBody generates requests for TorrentsFilePrioPost with any type of body
+func NewTorrentsFilePrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/filePrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsFilesPostRequestWithFormdataBody calls the generic TorrentsFilesPost builder with application/x-www-form-urlencoded body
+func NewTorrentsFilesPostRequestWithFormdataBody(server string, body TorrentsFilesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsFilesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsFilesPostRequestWithBody generates requests for TorrentsFilesPost with any type of body
+func NewTorrentsFilesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/files")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsIncreasePrioPostRequestWithFormdataBody calls the generic TorrentsIncreasePrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsIncreasePrioPostRequestWithFormdataBody(server string, body TorrentsIncreasePrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsIncreasePrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsIncreasePrioPostRequestWithBody generates requests for TorrentsIncreasePrioPost with any type of body
+func NewTorrentsIncreasePrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/increasePrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsInfoPostRequestWithFormdataBody calls the generic TorrentsInfoPost builder with application/x-www-form-urlencoded body
+func NewTorrentsInfoPostRequestWithFormdataBody(server string, body TorrentsInfoPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsInfoPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsInfoPostRequestWithBody generates requests for TorrentsInfoPost with any type of body
+func NewTorrentsInfoPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsPausePostRequestWithFormdataBody calls the generic TorrentsPausePost builder with application/x-www-form-urlencoded body
+func NewTorrentsPausePostRequestWithFormdataBody(server string, body TorrentsPausePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsPausePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsPausePostRequestWithBody generates requests for TorrentsPausePost with any type of body
+func NewTorrentsPausePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/pause")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsPieceHashesPostRequestWithFormdataBody calls the generic TorrentsPieceHashesPost builder with application/x-www-form-urlencoded body
+func NewTorrentsPieceHashesPostRequestWithFormdataBody(server string, body TorrentsPieceHashesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsPieceHashesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsPieceHashesPostRequestWithBody generates requests for TorrentsPieceHashesPost with any type of body
+func NewTorrentsPieceHashesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/pieceHashes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsPieceStatesPostRequestWithFormdataBody calls the generic TorrentsPieceStatesPost builder with application/x-www-form-urlencoded body
+func NewTorrentsPieceStatesPostRequestWithFormdataBody(server string, body TorrentsPieceStatesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsPieceStatesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsPieceStatesPostRequestWithBody generates requests for TorrentsPieceStatesPost with any type of body
+func NewTorrentsPieceStatesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/pieceStates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsPropertiesPostRequestWithFormdataBody calls the generic TorrentsPropertiesPost builder with application/x-www-form-urlencoded body
+func NewTorrentsPropertiesPostRequestWithFormdataBody(server string, body TorrentsPropertiesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsPropertiesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsPropertiesPostRequestWithBody generates requests for TorrentsPropertiesPost with any type of body
+func NewTorrentsPropertiesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/properties")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsReannouncePostRequestWithFormdataBody calls the generic TorrentsReannouncePost builder with application/x-www-form-urlencoded body
+func NewTorrentsReannouncePostRequestWithFormdataBody(server string, body TorrentsReannouncePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsReannouncePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsReannouncePostRequestWithBody generates requests for TorrentsReannouncePost with any type of body
+func NewTorrentsReannouncePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/reannounce")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRecheckPostRequestWithFormdataBody calls the generic TorrentsRecheckPost builder with application/x-www-form-urlencoded body
+func NewTorrentsRecheckPostRequestWithFormdataBody(server string, body TorrentsRecheckPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRecheckPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRecheckPostRequestWithBody generates requests for TorrentsRecheckPost with any type of body
+func NewTorrentsRecheckPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/recheck")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRemoveCategoriesPostRequestWithFormdataBody calls the generic TorrentsRemoveCategoriesPost builder with application/x-www-form-urlencoded body
+func NewTorrentsRemoveCategoriesPostRequestWithFormdataBody(server string, body TorrentsRemoveCategoriesPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRemoveCategoriesPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRemoveCategoriesPostRequestWithBody generates requests for TorrentsRemoveCategoriesPost with any type of body
+func NewTorrentsRemoveCategoriesPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/removeCategories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRemoveTagsPostRequestWithFormdataBody calls the generic TorrentsRemoveTagsPost builder with application/x-www-form-urlencoded body
+func NewTorrentsRemoveTagsPostRequestWithFormdataBody(server string, body TorrentsRemoveTagsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRemoveTagsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRemoveTagsPostRequestWithBody generates requests for TorrentsRemoveTagsPost with any type of body
+func NewTorrentsRemoveTagsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/removeTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRemoveTrackersPostRequestWithFormdataBody calls the generic TorrentsRemoveTrackersPost builder with application/x-www-form-urlencoded body
+func NewTorrentsRemoveTrackersPostRequestWithFormdataBody(server string, body TorrentsRemoveTrackersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRemoveTrackersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRemoveTrackersPostRequestWithBody generates requests for TorrentsRemoveTrackersPost with any type of body
+func NewTorrentsRemoveTrackersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/removeTrackers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRenamePostRequestWithFormdataBody calls the generic TorrentsRenamePost builder with application/x-www-form-urlencoded body
+func NewTorrentsRenamePostRequestWithFormdataBody(server string, body TorrentsRenamePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRenamePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRenamePostRequestWithBody generates requests for TorrentsRenamePost with any type of body
+func NewTorrentsRenamePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/rename")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRenameFilePostRequestWithFormdataBody calls the generic TorrentsRenameFilePost builder with application/x-www-form-urlencoded body
+func NewTorrentsRenameFilePostRequestWithFormdataBody(server string, body TorrentsRenameFilePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRenameFilePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRenameFilePostRequestWithBody generates requests for TorrentsRenameFilePost with any type of body
+func NewTorrentsRenameFilePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/renameFile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsRenameFolderPostRequestWithFormdataBody calls the generic TorrentsRenameFolderPost builder with application/x-www-form-urlencoded body
+func NewTorrentsRenameFolderPostRequestWithFormdataBody(server string, body TorrentsRenameFolderPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsRenameFolderPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsRenameFolderPostRequestWithBody generates requests for TorrentsRenameFolderPost with any type of body
+func NewTorrentsRenameFolderPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/renameFolder")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsResumePostRequestWithFormdataBody calls the generic TorrentsResumePost builder with application/x-www-form-urlencoded body
+func NewTorrentsResumePostRequestWithFormdataBody(server string, body TorrentsResumePostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsResumePostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsResumePostRequestWithBody generates requests for TorrentsResumePost with any type of body
+func NewTorrentsResumePostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/resume")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetAutoManagementPostRequestWithFormdataBody calls the generic TorrentsSetAutoManagementPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetAutoManagementPostRequestWithFormdataBody(server string, body TorrentsSetAutoManagementPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetAutoManagementPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetAutoManagementPostRequestWithBody generates requests for TorrentsSetAutoManagementPost with any type of body
+func NewTorrentsSetAutoManagementPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setAutoManagement")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetCategoryPostRequestWithFormdataBody calls the generic TorrentsSetCategoryPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetCategoryPostRequestWithFormdataBody(server string, body TorrentsSetCategoryPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetCategoryPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetCategoryPostRequestWithBody generates requests for TorrentsSetCategoryPost with any type of body
+func NewTorrentsSetCategoryPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setCategory")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetDownloadLimitPostRequestWithFormdataBody calls the generic TorrentsSetDownloadLimitPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetDownloadLimitPostRequestWithFormdataBody(server string, body TorrentsSetDownloadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetDownloadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetDownloadLimitPostRequestWithBody generates requests for TorrentsSetDownloadLimitPost with any type of body
+func NewTorrentsSetDownloadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setDownloadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetForceStartPostRequestWithFormdataBody calls the generic TorrentsSetForceStartPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetForceStartPostRequestWithFormdataBody(server string, body TorrentsSetForceStartPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetForceStartPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetForceStartPostRequestWithBody generates requests for TorrentsSetForceStartPost with any type of body
+func NewTorrentsSetForceStartPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setForceStart")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetLocationPostRequestWithFormdataBody calls the generic TorrentsSetLocationPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetLocationPostRequestWithFormdataBody(server string, body TorrentsSetLocationPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetLocationPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetLocationPostRequestWithBody generates requests for TorrentsSetLocationPost with any type of body
+func NewTorrentsSetLocationPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setLocation")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetShareLimitsPostRequestWithFormdataBody calls the generic TorrentsSetShareLimitsPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetShareLimitsPostRequestWithFormdataBody(server string, body TorrentsSetShareLimitsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetShareLimitsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetShareLimitsPostRequestWithBody generates requests for TorrentsSetShareLimitsPost with any type of body
+func NewTorrentsSetShareLimitsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setShareLimits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetSuperSeedingPostRequestWithFormdataBody calls the generic TorrentsSetSuperSeedingPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetSuperSeedingPostRequestWithFormdataBody(server string, body TorrentsSetSuperSeedingPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetSuperSeedingPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetSuperSeedingPostRequestWithBody generates requests for TorrentsSetSuperSeedingPost with any type of body
+func NewTorrentsSetSuperSeedingPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setSuperSeeding")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsSetUploadLimitPostRequestWithFormdataBody calls the generic TorrentsSetUploadLimitPost builder with application/x-www-form-urlencoded body
+func NewTorrentsSetUploadLimitPostRequestWithFormdataBody(server string, body TorrentsSetUploadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsSetUploadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsSetUploadLimitPostRequestWithBody generates requests for TorrentsSetUploadLimitPost with any type of body
+func NewTorrentsSetUploadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/setUploadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsStartPostRequestWithFormdataBody calls the generic TorrentsStartPost builder with application/x-www-form-urlencoded body
+func NewTorrentsStartPostRequestWithFormdataBody(server string, body TorrentsStartPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsStartPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsStartPostRequestWithBody generates requests for TorrentsStartPost with any type of body
+func NewTorrentsStartPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/start")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsStopPostRequestWithFormdataBody calls the generic TorrentsStopPost builder with application/x-www-form-urlencoded body
+func NewTorrentsStopPostRequestWithFormdataBody(server string, body TorrentsStopPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsStopPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsStopPostRequestWithBody generates requests for TorrentsStopPost with any type of body
+func NewTorrentsStopPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/stop")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsTagsGetRequest generates requests for TorrentsTagsGet
+func NewTorrentsTagsGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTorrentsToggleFirstLastPiecePrioPostRequestWithFormdataBody calls the generic TorrentsToggleFirstLastPiecePrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsToggleFirstLastPiecePrioPostRequestWithFormdataBody(server string, body TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsToggleFirstLastPiecePrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsToggleFirstLastPiecePrioPostRequestWithBody generates requests for TorrentsToggleFirstLastPiecePrioPost with any type of body
+func NewTorrentsToggleFirstLastPiecePrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/toggleFirstLastPiecePrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsToggleSequentialDownloadPostRequestWithFormdataBody calls the generic TorrentsToggleSequentialDownloadPost builder with application/x-www-form-urlencoded body
+func NewTorrentsToggleSequentialDownloadPostRequestWithFormdataBody(server string, body TorrentsToggleSequentialDownloadPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsToggleSequentialDownloadPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsToggleSequentialDownloadPostRequestWithBody generates requests for TorrentsToggleSequentialDownloadPost with any type of body
+func NewTorrentsToggleSequentialDownloadPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/toggleSequentialDownload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsTopPrioPostRequestWithFormdataBody calls the generic TorrentsTopPrioPost builder with application/x-www-form-urlencoded body
+func NewTorrentsTopPrioPostRequestWithFormdataBody(server string, body TorrentsTopPrioPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsTopPrioPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsTopPrioPostRequestWithBody generates requests for TorrentsTopPrioPost with any type of body
+func NewTorrentsTopPrioPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/topPrio")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsTrackersPostRequestWithFormdataBody calls the generic TorrentsTrackersPost builder with application/x-www-form-urlencoded body
+func NewTorrentsTrackersPostRequestWithFormdataBody(server string, body TorrentsTrackersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsTrackersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsTrackersPostRequestWithBody generates requests for TorrentsTrackersPost with any type of body
+func NewTorrentsTrackersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/trackers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentsUploadLimitPostRequestWithFormdataBody calls the generic TorrentsUploadLimitPost builder with application/x-www-form-urlencoded body
+func NewTorrentsUploadLimitPostRequestWithFormdataBody(server string, body TorrentsUploadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentsUploadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentsUploadLimitPostRequestWithBody generates requests for TorrentsUploadLimitPost with any type of body
+func NewTorrentsUploadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/uploadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTorrentWebseedsPostRequestWithFormdataBody calls the generic TorrentWebseedsPost builder with application/x-www-form-urlencoded body
+func NewTorrentWebseedsPostRequestWithFormdataBody(server string, body TorrentWebseedsPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTorrentWebseedsPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTorrentWebseedsPostRequestWithBody generates requests for TorrentWebseedsPost with any type of body
+func NewTorrentWebseedsPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/torrents/webseeds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferBanPeersPostRequestWithFormdataBody calls the generic TransferBanPeersPost builder with application/x-www-form-urlencoded body
+func NewTransferBanPeersPostRequestWithFormdataBody(server string, body TransferBanPeersPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTransferBanPeersPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTransferBanPeersPostRequestWithBody generates requests for TransferBanPeersPost with any type of body
+func NewTransferBanPeersPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/banPeers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferDownloadLimitGetRequest generates requests for TransferDownloadLimitGet
+func NewTransferDownloadLimitGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/downloadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransferInfoGetRequest generates requests for TransferInfoGet
+func NewTransferInfoGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/info")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransferSetDownloadLimitPostRequestWithFormdataBody calls the generic TransferSetDownloadLimitPost builder with application/x-www-form-urlencoded body
+func NewTransferSetDownloadLimitPostRequestWithFormdataBody(server string, body TransferSetDownloadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTransferSetDownloadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTransferSetDownloadLimitPostRequestWithBody generates requests for TransferSetDownloadLimitPost with any type of body
+func NewTransferSetDownloadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/setDownloadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferSetUploadLimitPostRequestWithFormdataBody calls the generic TransferSetUploadLimitPost builder with application/x-www-form-urlencoded body
+func NewTransferSetUploadLimitPostRequestWithFormdataBody(server string, body TransferSetUploadLimitPostFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewTransferSetUploadLimitPostRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewTransferSetUploadLimitPostRequestWithBody generates requests for TransferSetUploadLimitPost with any type of body
+func NewTransferSetUploadLimitPostRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/setUploadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransferSpeedLimitsModeGetRequest generates requests for TransferSpeedLimitsModeGet
+func NewTransferSpeedLimitsModeGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/speedLimitsMode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransferToggleSpeedLimitsModeGetRequest generates requests for TransferToggleSpeedLimitsModeGet
+func NewTransferToggleSpeedLimitsModeGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/toggleSpeedLimitsMode")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransferUploadLimitGetRequest generates requests for TransferUploadLimitGet
+func NewTransferUploadLimitGetRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/transfer/uploadLimit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// AppBuildInfoGetWithResponse request
+	AppBuildInfoGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppBuildInfoGetResponse, error)
+
+	// AppDefaultSavePathGetWithResponse request
+	AppDefaultSavePathGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppDefaultSavePathGetResponse, error)
+
+	// AppPreferencesGetWithResponse request
+	AppPreferencesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppPreferencesGetResponse, error)
+
+	// AppSetPreferencesPostWithBodyWithResponse request with any body
+	AppSetPreferencesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppSetPreferencesPostResponse, error)
+
+	AppSetPreferencesPostWithFormdataBodyWithResponse(ctx context.Context, body AppSetPreferencesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*AppSetPreferencesPostResponse, error)
+
+	// AppShutdownGetWithResponse request
+	AppShutdownGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppShutdownGetResponse, error)
+
+	// AppVersionGetWithResponse request
+	AppVersionGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppVersionGetResponse, error)
+
+	// AppWebapiVersionGetWithResponse request
+	AppWebapiVersionGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppWebapiVersionGetResponse, error)
+
+	// AuthLoginPostWithBodyWithResponse request with any body
+	AuthLoginPostWithBodyWithResponse(ctx context.Context, params *AuthLoginPostParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginPostResponse, error)
+
+	AuthLoginPostWithFormdataBodyWithResponse(ctx context.Context, params *AuthLoginPostParams, body AuthLoginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginPostResponse, error)
+
+	// AuthLogoutPostWithResponse request
+	AuthLogoutPostWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthLogoutPostResponse, error)
+
+	// LogMainPostWithBodyWithResponse request with any body
+	LogMainPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogMainPostResponse, error)
+
+	LogMainPostWithFormdataBodyWithResponse(ctx context.Context, body LogMainPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*LogMainPostResponse, error)
+
+	// LogPeersPostWithBodyWithResponse request with any body
+	LogPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogPeersPostResponse, error)
+
+	LogPeersPostWithFormdataBodyWithResponse(ctx context.Context, body LogPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*LogPeersPostResponse, error)
+
+	// RssAddFeedPostWithBodyWithResponse request with any body
+	RssAddFeedPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssAddFeedPostResponse, error)
+
+	RssAddFeedPostWithFormdataBodyWithResponse(ctx context.Context, body RssAddFeedPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssAddFeedPostResponse, error)
+
+	// RssAddFolderPostWithBodyWithResponse request with any body
+	RssAddFolderPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssAddFolderPostResponse, error)
+
+	RssAddFolderPostWithFormdataBodyWithResponse(ctx context.Context, body RssAddFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssAddFolderPostResponse, error)
+
+	// RssItemsPostWithBodyWithResponse request with any body
+	RssItemsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssItemsPostResponse, error)
+
+	RssItemsPostWithFormdataBodyWithResponse(ctx context.Context, body RssItemsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssItemsPostResponse, error)
+
+	// RssMarkAsReadPostWithBodyWithResponse request with any body
+	RssMarkAsReadPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMarkAsReadPostResponse, error)
+
+	RssMarkAsReadPostWithFormdataBodyWithResponse(ctx context.Context, body RssMarkAsReadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMarkAsReadPostResponse, error)
+
+	// RssMatchingArticlesPostWithBodyWithResponse request with any body
+	RssMatchingArticlesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMatchingArticlesPostResponse, error)
+
+	RssMatchingArticlesPostWithFormdataBodyWithResponse(ctx context.Context, body RssMatchingArticlesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMatchingArticlesPostResponse, error)
+
+	// RssMoveItemPostWithBodyWithResponse request with any body
+	RssMoveItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMoveItemPostResponse, error)
+
+	RssMoveItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssMoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMoveItemPostResponse, error)
+
+	// RssRefreshItemPostWithBodyWithResponse request with any body
+	RssRefreshItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRefreshItemPostResponse, error)
+
+	RssRefreshItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssRefreshItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRefreshItemPostResponse, error)
+
+	// RssRemoveItemPostWithBodyWithResponse request with any body
+	RssRemoveItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRemoveItemPostResponse, error)
+
+	RssRemoveItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssRemoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRemoveItemPostResponse, error)
+
+	// RssRemoveRulePostWithBodyWithResponse request with any body
+	RssRemoveRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRemoveRulePostResponse, error)
+
+	RssRemoveRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssRemoveRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRemoveRulePostResponse, error)
+
+	// RssRenameRulePostWithBodyWithResponse request with any body
+	RssRenameRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRenameRulePostResponse, error)
+
+	RssRenameRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssRenameRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRenameRulePostResponse, error)
+
+	// RssRulesGetWithResponse request
+	RssRulesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RssRulesGetResponse, error)
+
+	// RssSetRulePostWithBodyWithResponse request with any body
+	RssSetRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssSetRulePostResponse, error)
+
+	RssSetRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssSetRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssSetRulePostResponse, error)
+
+	// SearchDeletePostWithBodyWithResponse request with any body
+	SearchDeletePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchDeletePostResponse, error)
+
+	SearchDeletePostWithFormdataBodyWithResponse(ctx context.Context, body SearchDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchDeletePostResponse, error)
+
+	// SearchEnablePluginPostWithBodyWithResponse request with any body
+	SearchEnablePluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchEnablePluginPostResponse, error)
+
+	SearchEnablePluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchEnablePluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchEnablePluginPostResponse, error)
+
+	// SearchInstallPluginPostWithBodyWithResponse request with any body
+	SearchInstallPluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchInstallPluginPostResponse, error)
+
+	SearchInstallPluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchInstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchInstallPluginPostResponse, error)
+
+	// SearchPluginsGetWithResponse request
+	SearchPluginsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SearchPluginsGetResponse, error)
+
+	// SearchResultsPostWithBodyWithResponse request with any body
+	SearchResultsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResultsPostResponse, error)
+
+	SearchResultsPostWithFormdataBodyWithResponse(ctx context.Context, body SearchResultsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchResultsPostResponse, error)
+
+	// SearchStartPostWithBodyWithResponse request with any body
+	SearchStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStartPostResponse, error)
+
+	SearchStartPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStartPostResponse, error)
+
+	// SearchStatusPostWithBodyWithResponse request with any body
+	SearchStatusPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStatusPostResponse, error)
+
+	SearchStatusPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStatusPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStatusPostResponse, error)
+
+	// SearchStopPostWithBodyWithResponse request with any body
+	SearchStopPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStopPostResponse, error)
+
+	SearchStopPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStopPostResponse, error)
+
+	// SearchUninstallPluginPostWithBodyWithResponse request with any body
+	SearchUninstallPluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchUninstallPluginPostResponse, error)
+
+	SearchUninstallPluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchUninstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchUninstallPluginPostResponse, error)
+
+	// SearchUpdatePluginsPostWithResponse request
+	SearchUpdatePluginsPostWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SearchUpdatePluginsPostResponse, error)
+
+	// SyncMaindataPostWithBodyWithResponse request with any body
+	SyncMaindataPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncMaindataPostResponse, error)
+
+	SyncMaindataPostWithFormdataBodyWithResponse(ctx context.Context, body SyncMaindataPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SyncMaindataPostResponse, error)
+
+	// SyncTorrentPeersPostWithBodyWithResponse request with any body
+	SyncTorrentPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncTorrentPeersPostResponse, error)
+
+	SyncTorrentPeersPostWithFormdataBodyWithResponse(ctx context.Context, body SyncTorrentPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SyncTorrentPeersPostResponse, error)
+
+	// TorrentsAddPostWithBodyWithResponse request with any body
+	TorrentsAddPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddPostResponse, error)
+
+	// TorrentsAddPeersPostWithBodyWithResponse request with any body
+	TorrentsAddPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddPeersPostResponse, error)
+
+	TorrentsAddPeersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddPeersPostResponse, error)
+
+	// TorrentsAddTagsPostWithBodyWithResponse request with any body
+	TorrentsAddTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddTagsPostResponse, error)
+
+	TorrentsAddTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddTagsPostResponse, error)
+
+	// TorrentsAddTrackersPostWithBodyWithResponse request with any body
+	TorrentsAddTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddTrackersPostResponse, error)
+
+	TorrentsAddTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddTrackersPostResponse, error)
+
+	// TorrentsBottomPrioPostWithBodyWithResponse request with any body
+	TorrentsBottomPrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsBottomPrioPostResponse, error)
+
+	TorrentsBottomPrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsBottomPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsBottomPrioPostResponse, error)
+
+	// TorrentsCategoriesGetWithResponse request
+	TorrentsCategoriesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TorrentsCategoriesGetResponse, error)
+
+	// TorrentsCreateCategoryPostWithBodyWithResponse request with any body
+	TorrentsCreateCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsCreateCategoryPostResponse, error)
+
+	TorrentsCreateCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsCreateCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsCreateCategoryPostResponse, error)
+
+	// TorrentsCreateTagsPostWithBodyWithResponse request with any body
+	TorrentsCreateTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsCreateTagsPostResponse, error)
+
+	TorrentsCreateTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsCreateTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsCreateTagsPostResponse, error)
+
+	// TorrentsDecreasePrioPostWithBodyWithResponse request with any body
+	TorrentsDecreasePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDecreasePrioPostResponse, error)
+
+	TorrentsDecreasePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDecreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDecreasePrioPostResponse, error)
+
+	// TorrentsDeletePostWithBodyWithResponse request with any body
+	TorrentsDeletePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDeletePostResponse, error)
+
+	TorrentsDeletePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDeletePostResponse, error)
+
+	// TorrentsDeleteTagsPostWithBodyWithResponse request with any body
+	TorrentsDeleteTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDeleteTagsPostResponse, error)
+
+	TorrentsDeleteTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDeleteTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDeleteTagsPostResponse, error)
+
+	// TorrentsDownloadLimitPostWithBodyWithResponse request with any body
+	TorrentsDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDownloadLimitPostResponse, error)
+
+	TorrentsDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDownloadLimitPostResponse, error)
+
+	// TorrentsEditCategoryPostWithBodyWithResponse request with any body
+	TorrentsEditCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsEditCategoryPostResponse, error)
+
+	TorrentsEditCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsEditCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsEditCategoryPostResponse, error)
+
+	// TorrentsEditTrackerPostWithBodyWithResponse request with any body
+	TorrentsEditTrackerPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsEditTrackerPostResponse, error)
+
+	TorrentsEditTrackerPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsEditTrackerPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsEditTrackerPostResponse, error)
+
+	// TorrentsFilePrioPostWithBodyWithResponse request with any body
+	TorrentsFilePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsFilePrioPostResponse, error)
+
+	TorrentsFilePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsFilePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsFilePrioPostResponse, error)
+
+	// TorrentsFilesPostWithBodyWithResponse request with any body
+	TorrentsFilesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsFilesPostResponse, error)
+
+	TorrentsFilesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsFilesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsFilesPostResponse, error)
+
+	// TorrentsIncreasePrioPostWithBodyWithResponse request with any body
+	TorrentsIncreasePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsIncreasePrioPostResponse, error)
+
+	TorrentsIncreasePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsIncreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsIncreasePrioPostResponse, error)
+
+	// TorrentsInfoPostWithBodyWithResponse request with any body
+	TorrentsInfoPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsInfoPostResponse, error)
+
+	TorrentsInfoPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsInfoPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsInfoPostResponse, error)
+
+	// TorrentsPausePostWithBodyWithResponse request with any body
+	TorrentsPausePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPausePostResponse, error)
+
+	TorrentsPausePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPausePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPausePostResponse, error)
+
+	// TorrentsPieceHashesPostWithBodyWithResponse request with any body
+	TorrentsPieceHashesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPieceHashesPostResponse, error)
+
+	TorrentsPieceHashesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPieceHashesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPieceHashesPostResponse, error)
+
+	// TorrentsPieceStatesPostWithBodyWithResponse request with any body
+	TorrentsPieceStatesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPieceStatesPostResponse, error)
+
+	TorrentsPieceStatesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPieceStatesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPieceStatesPostResponse, error)
+
+	// TorrentsPropertiesPostWithBodyWithResponse request with any body
+	TorrentsPropertiesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPropertiesPostResponse, error)
+
+	TorrentsPropertiesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPropertiesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPropertiesPostResponse, error)
+
+	// TorrentsReannouncePostWithBodyWithResponse request with any body
+	TorrentsReannouncePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsReannouncePostResponse, error)
+
+	TorrentsReannouncePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsReannouncePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsReannouncePostResponse, error)
+
+	// TorrentsRecheckPostWithBodyWithResponse request with any body
+	TorrentsRecheckPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRecheckPostResponse, error)
+
+	TorrentsRecheckPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRecheckPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRecheckPostResponse, error)
+
+	// TorrentsRemoveCategoriesPostWithBodyWithResponse request with any body
+	TorrentsRemoveCategoriesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveCategoriesPostResponse, error)
+
+	TorrentsRemoveCategoriesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveCategoriesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveCategoriesPostResponse, error)
+
+	// TorrentsRemoveTagsPostWithBodyWithResponse request with any body
+	TorrentsRemoveTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveTagsPostResponse, error)
+
+	TorrentsRemoveTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveTagsPostResponse, error)
+
+	// TorrentsRemoveTrackersPostWithBodyWithResponse request with any body
+	TorrentsRemoveTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveTrackersPostResponse, error)
+
+	TorrentsRemoveTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveTrackersPostResponse, error)
+
+	// TorrentsRenamePostWithBodyWithResponse request with any body
+	TorrentsRenamePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenamePostResponse, error)
+
+	TorrentsRenamePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenamePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenamePostResponse, error)
+
+	// TorrentsRenameFilePostWithBodyWithResponse request with any body
+	TorrentsRenameFilePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenameFilePostResponse, error)
+
+	TorrentsRenameFilePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenameFilePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenameFilePostResponse, error)
+
+	// TorrentsRenameFolderPostWithBodyWithResponse request with any body
+	TorrentsRenameFolderPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenameFolderPostResponse, error)
+
+	TorrentsRenameFolderPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenameFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenameFolderPostResponse, error)
+
+	// TorrentsResumePostWithBodyWithResponse request with any body
+	TorrentsResumePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsResumePostResponse, error)
+
+	TorrentsResumePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsResumePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsResumePostResponse, error)
+
+	// TorrentsSetAutoManagementPostWithBodyWithResponse request with any body
+	TorrentsSetAutoManagementPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetAutoManagementPostResponse, error)
+
+	TorrentsSetAutoManagementPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetAutoManagementPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetAutoManagementPostResponse, error)
+
+	// TorrentsSetCategoryPostWithBodyWithResponse request with any body
+	TorrentsSetCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetCategoryPostResponse, error)
+
+	TorrentsSetCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetCategoryPostResponse, error)
+
+	// TorrentsSetDownloadLimitPostWithBodyWithResponse request with any body
+	TorrentsSetDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetDownloadLimitPostResponse, error)
+
+	TorrentsSetDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetDownloadLimitPostResponse, error)
+
+	// TorrentsSetForceStartPostWithBodyWithResponse request with any body
+	TorrentsSetForceStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetForceStartPostResponse, error)
+
+	TorrentsSetForceStartPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetForceStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetForceStartPostResponse, error)
+
+	// TorrentsSetLocationPostWithBodyWithResponse request with any body
+	TorrentsSetLocationPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetLocationPostResponse, error)
+
+	TorrentsSetLocationPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetLocationPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetLocationPostResponse, error)
+
+	// TorrentsSetShareLimitsPostWithBodyWithResponse request with any body
+	TorrentsSetShareLimitsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetShareLimitsPostResponse, error)
+
+	TorrentsSetShareLimitsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetShareLimitsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetShareLimitsPostResponse, error)
+
+	// TorrentsSetSuperSeedingPostWithBodyWithResponse request with any body
+	TorrentsSetSuperSeedingPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetSuperSeedingPostResponse, error)
+
+	TorrentsSetSuperSeedingPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetSuperSeedingPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetSuperSeedingPostResponse, error)
+
+	// TorrentsSetUploadLimitPostWithBodyWithResponse request with any body
+	TorrentsSetUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetUploadLimitPostResponse, error)
+
+	TorrentsSetUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetUploadLimitPostResponse, error)
+
+	// TorrentsStartPostWithBodyWithResponse request with any body
+	TorrentsStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsStartPostResponse, error)
+
+	TorrentsStartPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsStartPostResponse, error)
+
+	// TorrentsStopPostWithBodyWithResponse request with any body
+	TorrentsStopPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsStopPostResponse, error)
+
+	TorrentsStopPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsStopPostResponse, error)
+
+	// TorrentsTagsGetWithResponse request
+	TorrentsTagsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TorrentsTagsGetResponse, error)
+
+	// TorrentsToggleFirstLastPiecePrioPostWithBodyWithResponse request with any body
+	TorrentsToggleFirstLastPiecePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsToggleFirstLastPiecePrioPostResponse, error)
+
+	TorrentsToggleFirstLastPiecePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsToggleFirstLastPiecePrioPostResponse, error)
+
+	// TorrentsToggleSequentialDownloadPostWithBodyWithResponse request with any body
+	TorrentsToggleSequentialDownloadPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsToggleSequentialDownloadPostResponse, error)
+
+	TorrentsToggleSequentialDownloadPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsToggleSequentialDownloadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsToggleSequentialDownloadPostResponse, error)
+
+	// TorrentsTopPrioPostWithBodyWithResponse request with any body
+	TorrentsTopPrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsTopPrioPostResponse, error)
+
+	TorrentsTopPrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsTopPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsTopPrioPostResponse, error)
+
+	// TorrentsTrackersPostWithBodyWithResponse request with any body
+	TorrentsTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsTrackersPostResponse, error)
+
+	TorrentsTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsTrackersPostResponse, error)
+
+	// TorrentsUploadLimitPostWithBodyWithResponse request with any body
+	TorrentsUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsUploadLimitPostResponse, error)
+
+	TorrentsUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsUploadLimitPostResponse, error)
+
+	// TorrentWebseedsPostWithBodyWithResponse request with any body
+	TorrentWebseedsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentWebseedsPostResponse, error)
+
+	TorrentWebseedsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentWebseedsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentWebseedsPostResponse, error)
+
+	// TransferBanPeersPostWithBodyWithResponse request with any body
+	TransferBanPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferBanPeersPostResponse, error)
+
+	TransferBanPeersPostWithFormdataBodyWithResponse(ctx context.Context, body TransferBanPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferBanPeersPostResponse, error)
+
+	// TransferDownloadLimitGetWithResponse request
+	TransferDownloadLimitGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferDownloadLimitGetResponse, error)
+
+	// TransferInfoGetWithResponse request
+	TransferInfoGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferInfoGetResponse, error)
+
+	// TransferSetDownloadLimitPostWithBodyWithResponse request with any body
+	TransferSetDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferSetDownloadLimitPostResponse, error)
+
+	TransferSetDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TransferSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferSetDownloadLimitPostResponse, error)
+
+	// TransferSetUploadLimitPostWithBodyWithResponse request with any body
+	TransferSetUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferSetUploadLimitPostResponse, error)
+
+	TransferSetUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TransferSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferSetUploadLimitPostResponse, error)
+
+	// TransferSpeedLimitsModeGetWithResponse request
+	TransferSpeedLimitsModeGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferSpeedLimitsModeGetResponse, error)
+
+	// TransferToggleSpeedLimitsModeGetWithResponse request
+	TransferToggleSpeedLimitsModeGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferToggleSpeedLimitsModeGetResponse, error)
+
+	// TransferUploadLimitGetWithResponse request
+	TransferUploadLimitGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferUploadLimitGetResponse, error)
+}
+
+type AppBuildInfoGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BuildInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r AppBuildInfoGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppBuildInfoGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppDefaultSavePathGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AppDefaultSavePathGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppDefaultSavePathGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppPreferencesGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Preferences
+}
+
+// Status returns HTTPResponse.Status
+func (r AppPreferencesGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppPreferencesGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppSetPreferencesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AppSetPreferencesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppSetPreferencesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppShutdownGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AppShutdownGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppShutdownGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppVersionGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AppVersionGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppVersionGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AppWebapiVersionGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AppWebapiVersionGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AppWebapiVersionGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthLoginPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthLoginPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthLoginPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AuthLogoutPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AuthLogoutPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AuthLogoutPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogMainPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MainLog
+}
+
+// Status returns HTTPResponse.Status
+func (r LogMainPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogMainPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogPeersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PeersLog
+}
+
+// Status returns HTTPResponse.Status
+func (r LogPeersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogPeersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssAddFeedPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssAddFeedPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssAddFeedPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssAddFolderPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssAddFolderPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssAddFolderPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssItemsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r RssItemsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssItemsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssMarkAsReadPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssMarkAsReadPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssMarkAsReadPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssMatchingArticlesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string][]string
+}
+
+// Status returns HTTPResponse.Status
+func (r RssMatchingArticlesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssMatchingArticlesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssMoveItemPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssMoveItemPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssMoveItemPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssRefreshItemPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssRefreshItemPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssRefreshItemPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssRemoveItemPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssRemoveItemPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssRemoveItemPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssRemoveRulePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssRemoveRulePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssRemoveRulePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssRenameRulePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssRenameRulePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssRenameRulePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssRulesGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]RssRuleDef
+}
+
+// Status returns HTTPResponse.Status
+func (r RssRulesGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssRulesGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RssSetRulePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RssSetRulePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RssSetRulePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchDeletePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDeletePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDeletePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchEnablePluginPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchEnablePluginPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchEnablePluginPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchInstallPluginPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchInstallPluginPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchInstallPluginPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchPluginsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SearchPlugin
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchPluginsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchPluginsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchResultsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResults
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchResultsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchResultsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchStartPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchJob
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchStartPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchStartPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchStatusPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SearchJobStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchStatusPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchStatusPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchStopPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchStopPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchStopPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchUninstallPluginPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchUninstallPluginPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchUninstallPluginPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchUpdatePluginsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchUpdatePluginsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchUpdatePluginsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncMaindataPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MainData
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncMaindataPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncMaindataPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SyncTorrentPeersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TorrentPeers
+}
+
+// Status returns HTTPResponse.Status
+func (r SyncTorrentPeersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SyncTorrentPeersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsAddPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsAddPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsAddPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsAddPeersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsAddPeersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsAddPeersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsAddTagsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsAddTagsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsAddTagsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsAddTrackersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsAddTrackersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsAddTrackersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsBottomPrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsBottomPrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsBottomPrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsCategoriesGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *map[string]TorrentsCategory
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsCategoriesGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsCategoriesGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsCreateCategoryPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsCreateCategoryPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsCreateCategoryPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsCreateTagsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsCreateTagsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsCreateTagsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsDecreasePrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsDecreasePrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsDecreasePrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsDeletePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsDeletePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsDeletePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsDeleteTagsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsDeleteTagsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsDeleteTagsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsDownloadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TorrentsLimit
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsDownloadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsDownloadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsEditCategoryPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsEditCategoryPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsEditCategoryPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsEditTrackerPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsEditTrackerPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsEditTrackerPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsFilePrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsFilePrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsFilePrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsFilesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TorrentsFiles
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsFilesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsFilesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsIncreasePrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsIncreasePrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsIncreasePrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsInfoPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TorrentInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsInfoPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsInfoPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsPausePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsPausePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsPausePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsPieceHashesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsPieceHashesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsPieceHashesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsPieceStatesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TorrentsPieceStatesPost200
+}
+type TorrentsPieceStatesPost200 int32
+
+// Status returns HTTPResponse.Status
+func (r TorrentsPieceStatesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsPieceStatesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsPropertiesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TorrentsProperties
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsPropertiesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsPropertiesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsReannouncePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsReannouncePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsReannouncePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRecheckPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRecheckPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRecheckPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRemoveCategoriesPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRemoveCategoriesPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRemoveCategoriesPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRemoveTagsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRemoveTagsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRemoveTagsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRemoveTrackersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRemoveTrackersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRemoveTrackersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRenamePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRenamePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRenamePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRenameFilePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRenameFilePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRenameFilePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsRenameFolderPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsRenameFolderPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsRenameFolderPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsResumePostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsResumePostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsResumePostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetAutoManagementPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetAutoManagementPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetAutoManagementPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetCategoryPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetCategoryPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetCategoryPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetDownloadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetDownloadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetDownloadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetForceStartPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetForceStartPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetForceStartPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetLocationPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetLocationPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetLocationPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetShareLimitsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetShareLimitsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetShareLimitsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetSuperSeedingPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetSuperSeedingPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetSuperSeedingPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsSetUploadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsSetUploadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsSetUploadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsStartPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsStartPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsStartPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsStopPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsStopPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsStopPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsTagsGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsTagsGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsTagsGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsToggleFirstLastPiecePrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsToggleFirstLastPiecePrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsToggleFirstLastPiecePrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsToggleSequentialDownloadPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsToggleSequentialDownloadPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsToggleSequentialDownloadPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsTopPrioPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsTopPrioPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsTopPrioPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsTrackersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TorrentsTrackers
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsTrackersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsTrackersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentsUploadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TorrentsLimit
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentsUploadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentsUploadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TorrentWebseedsPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TorrentsWebseeds
+}
+
+// Status returns HTTPResponse.Status
+func (r TorrentWebseedsPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TorrentWebseedsPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferBanPeersPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferBanPeersPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferBanPeersPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferDownloadLimitGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferDownloadLimitGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferDownloadLimitGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferInfoGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferInfo
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferInfoGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferInfoGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferSetDownloadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferSetDownloadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferSetDownloadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferSetUploadLimitPostResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferSetUploadLimitPostResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferSetUploadLimitPostResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferSpeedLimitsModeGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferSpeedLimitsModeGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferSpeedLimitsModeGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferToggleSpeedLimitsModeGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferToggleSpeedLimitsModeGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferToggleSpeedLimitsModeGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransferUploadLimitGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r TransferUploadLimitGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransferUploadLimitGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// AppBuildInfoGetWithResponse request returning *AppBuildInfoGetResponse
+func (c *ClientWithResponses) AppBuildInfoGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppBuildInfoGetResponse, error) {
+	rsp, err := c.AppBuildInfoGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppBuildInfoGetResponse(rsp)
+}
+
+// AppDefaultSavePathGetWithResponse request returning *AppDefaultSavePathGetResponse
+func (c *ClientWithResponses) AppDefaultSavePathGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppDefaultSavePathGetResponse, error) {
+	rsp, err := c.AppDefaultSavePathGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppDefaultSavePathGetResponse(rsp)
+}
+
+// AppPreferencesGetWithResponse request returning *AppPreferencesGetResponse
+func (c *ClientWithResponses) AppPreferencesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppPreferencesGetResponse, error) {
+	rsp, err := c.AppPreferencesGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppPreferencesGetResponse(rsp)
+}
+
+// AppSetPreferencesPostWithBodyWithResponse request with arbitrary body returning *AppSetPreferencesPostResponse
+func (c *ClientWithResponses) AppSetPreferencesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AppSetPreferencesPostResponse, error) {
+	rsp, err := c.AppSetPreferencesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSetPreferencesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) AppSetPreferencesPostWithFormdataBodyWithResponse(ctx context.Context, body AppSetPreferencesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*AppSetPreferencesPostResponse, error) {
+	rsp, err := c.AppSetPreferencesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppSetPreferencesPostResponse(rsp)
+}
+
+// AppShutdownGetWithResponse request returning *AppShutdownGetResponse
+func (c *ClientWithResponses) AppShutdownGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppShutdownGetResponse, error) {
+	rsp, err := c.AppShutdownGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppShutdownGetResponse(rsp)
+}
+
+// AppVersionGetWithResponse request returning *AppVersionGetResponse
+func (c *ClientWithResponses) AppVersionGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppVersionGetResponse, error) {
+	rsp, err := c.AppVersionGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppVersionGetResponse(rsp)
+}
+
+// AppWebapiVersionGetWithResponse request returning *AppWebapiVersionGetResponse
+func (c *ClientWithResponses) AppWebapiVersionGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AppWebapiVersionGetResponse, error) {
+	rsp, err := c.AppWebapiVersionGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAppWebapiVersionGetResponse(rsp)
+}
+
+// AuthLoginPostWithBodyWithResponse request with arbitrary body returning *AuthLoginPostResponse
+func (c *ClientWithResponses) AuthLoginPostWithBodyWithResponse(ctx context.Context, params *AuthLoginPostParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AuthLoginPostResponse, error) {
+	rsp, err := c.AuthLoginPostWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) AuthLoginPostWithFormdataBodyWithResponse(ctx context.Context, params *AuthLoginPostParams, body AuthLoginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*AuthLoginPostResponse, error) {
+	rsp, err := c.AuthLoginPostWithFormdataBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLoginPostResponse(rsp)
+}
+
+// AuthLogoutPostWithResponse request returning *AuthLogoutPostResponse
+func (c *ClientWithResponses) AuthLogoutPostWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*AuthLogoutPostResponse, error) {
+	rsp, err := c.AuthLogoutPost(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAuthLogoutPostResponse(rsp)
+}
+
+// LogMainPostWithBodyWithResponse request with arbitrary body returning *LogMainPostResponse
+func (c *ClientWithResponses) LogMainPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogMainPostResponse, error) {
+	rsp, err := c.LogMainPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogMainPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) LogMainPostWithFormdataBodyWithResponse(ctx context.Context, body LogMainPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*LogMainPostResponse, error) {
+	rsp, err := c.LogMainPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogMainPostResponse(rsp)
+}
+
+// LogPeersPostWithBodyWithResponse request with arbitrary body returning *LogPeersPostResponse
+func (c *ClientWithResponses) LogPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogPeersPostResponse, error) {
+	rsp, err := c.LogPeersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogPeersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) LogPeersPostWithFormdataBodyWithResponse(ctx context.Context, body LogPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*LogPeersPostResponse, error) {
+	rsp, err := c.LogPeersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogPeersPostResponse(rsp)
+}
+
+// RssAddFeedPostWithBodyWithResponse request with arbitrary body returning *RssAddFeedPostResponse
+func (c *ClientWithResponses) RssAddFeedPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssAddFeedPostResponse, error) {
+	rsp, err := c.RssAddFeedPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssAddFeedPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssAddFeedPostWithFormdataBodyWithResponse(ctx context.Context, body RssAddFeedPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssAddFeedPostResponse, error) {
+	rsp, err := c.RssAddFeedPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssAddFeedPostResponse(rsp)
+}
+
+// RssAddFolderPostWithBodyWithResponse request with arbitrary body returning *RssAddFolderPostResponse
+func (c *ClientWithResponses) RssAddFolderPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssAddFolderPostResponse, error) {
+	rsp, err := c.RssAddFolderPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssAddFolderPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssAddFolderPostWithFormdataBodyWithResponse(ctx context.Context, body RssAddFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssAddFolderPostResponse, error) {
+	rsp, err := c.RssAddFolderPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssAddFolderPostResponse(rsp)
+}
+
+// RssItemsPostWithBodyWithResponse request with arbitrary body returning *RssItemsPostResponse
+func (c *ClientWithResponses) RssItemsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssItemsPostResponse, error) {
+	rsp, err := c.RssItemsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssItemsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssItemsPostWithFormdataBodyWithResponse(ctx context.Context, body RssItemsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssItemsPostResponse, error) {
+	rsp, err := c.RssItemsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssItemsPostResponse(rsp)
+}
+
+// RssMarkAsReadPostWithBodyWithResponse request with arbitrary body returning *RssMarkAsReadPostResponse
+func (c *ClientWithResponses) RssMarkAsReadPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMarkAsReadPostResponse, error) {
+	rsp, err := c.RssMarkAsReadPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMarkAsReadPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssMarkAsReadPostWithFormdataBodyWithResponse(ctx context.Context, body RssMarkAsReadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMarkAsReadPostResponse, error) {
+	rsp, err := c.RssMarkAsReadPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMarkAsReadPostResponse(rsp)
+}
+
+// RssMatchingArticlesPostWithBodyWithResponse request with arbitrary body returning *RssMatchingArticlesPostResponse
+func (c *ClientWithResponses) RssMatchingArticlesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMatchingArticlesPostResponse, error) {
+	rsp, err := c.RssMatchingArticlesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMatchingArticlesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssMatchingArticlesPostWithFormdataBodyWithResponse(ctx context.Context, body RssMatchingArticlesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMatchingArticlesPostResponse, error) {
+	rsp, err := c.RssMatchingArticlesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMatchingArticlesPostResponse(rsp)
+}
+
+// RssMoveItemPostWithBodyWithResponse request with arbitrary body returning *RssMoveItemPostResponse
+func (c *ClientWithResponses) RssMoveItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssMoveItemPostResponse, error) {
+	rsp, err := c.RssMoveItemPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMoveItemPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssMoveItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssMoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssMoveItemPostResponse, error) {
+	rsp, err := c.RssMoveItemPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssMoveItemPostResponse(rsp)
+}
+
+// RssRefreshItemPostWithBodyWithResponse request with arbitrary body returning *RssRefreshItemPostResponse
+func (c *ClientWithResponses) RssRefreshItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRefreshItemPostResponse, error) {
+	rsp, err := c.RssRefreshItemPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRefreshItemPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssRefreshItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssRefreshItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRefreshItemPostResponse, error) {
+	rsp, err := c.RssRefreshItemPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRefreshItemPostResponse(rsp)
+}
+
+// RssRemoveItemPostWithBodyWithResponse request with arbitrary body returning *RssRemoveItemPostResponse
+func (c *ClientWithResponses) RssRemoveItemPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRemoveItemPostResponse, error) {
+	rsp, err := c.RssRemoveItemPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRemoveItemPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssRemoveItemPostWithFormdataBodyWithResponse(ctx context.Context, body RssRemoveItemPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRemoveItemPostResponse, error) {
+	rsp, err := c.RssRemoveItemPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRemoveItemPostResponse(rsp)
+}
+
+// RssRemoveRulePostWithBodyWithResponse request with arbitrary body returning *RssRemoveRulePostResponse
+func (c *ClientWithResponses) RssRemoveRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRemoveRulePostResponse, error) {
+	rsp, err := c.RssRemoveRulePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRemoveRulePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssRemoveRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssRemoveRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRemoveRulePostResponse, error) {
+	rsp, err := c.RssRemoveRulePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRemoveRulePostResponse(rsp)
+}
+
+// RssRenameRulePostWithBodyWithResponse request with arbitrary body returning *RssRenameRulePostResponse
+func (c *ClientWithResponses) RssRenameRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssRenameRulePostResponse, error) {
+	rsp, err := c.RssRenameRulePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRenameRulePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssRenameRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssRenameRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssRenameRulePostResponse, error) {
+	rsp, err := c.RssRenameRulePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRenameRulePostResponse(rsp)
+}
+
+// RssRulesGetWithResponse request returning *RssRulesGetResponse
+func (c *ClientWithResponses) RssRulesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RssRulesGetResponse, error) {
+	rsp, err := c.RssRulesGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssRulesGetResponse(rsp)
+}
+
+// RssSetRulePostWithBodyWithResponse request with arbitrary body returning *RssSetRulePostResponse
+func (c *ClientWithResponses) RssSetRulePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RssSetRulePostResponse, error) {
+	rsp, err := c.RssSetRulePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssSetRulePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) RssSetRulePostWithFormdataBodyWithResponse(ctx context.Context, body RssSetRulePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*RssSetRulePostResponse, error) {
+	rsp, err := c.RssSetRulePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRssSetRulePostResponse(rsp)
+}
+
+// SearchDeletePostWithBodyWithResponse request with arbitrary body returning *SearchDeletePostResponse
+func (c *ClientWithResponses) SearchDeletePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchDeletePostResponse, error) {
+	rsp, err := c.SearchDeletePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDeletePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchDeletePostWithFormdataBodyWithResponse(ctx context.Context, body SearchDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchDeletePostResponse, error) {
+	rsp, err := c.SearchDeletePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDeletePostResponse(rsp)
+}
+
+// SearchEnablePluginPostWithBodyWithResponse request with arbitrary body returning *SearchEnablePluginPostResponse
+func (c *ClientWithResponses) SearchEnablePluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchEnablePluginPostResponse, error) {
+	rsp, err := c.SearchEnablePluginPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchEnablePluginPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchEnablePluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchEnablePluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchEnablePluginPostResponse, error) {
+	rsp, err := c.SearchEnablePluginPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchEnablePluginPostResponse(rsp)
+}
+
+// SearchInstallPluginPostWithBodyWithResponse request with arbitrary body returning *SearchInstallPluginPostResponse
+func (c *ClientWithResponses) SearchInstallPluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchInstallPluginPostResponse, error) {
+	rsp, err := c.SearchInstallPluginPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchInstallPluginPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchInstallPluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchInstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchInstallPluginPostResponse, error) {
+	rsp, err := c.SearchInstallPluginPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchInstallPluginPostResponse(rsp)
+}
+
+// SearchPluginsGetWithResponse request returning *SearchPluginsGetResponse
+func (c *ClientWithResponses) SearchPluginsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SearchPluginsGetResponse, error) {
+	rsp, err := c.SearchPluginsGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchPluginsGetResponse(rsp)
+}
+
+// SearchResultsPostWithBodyWithResponse request with arbitrary body returning *SearchResultsPostResponse
+func (c *ClientWithResponses) SearchResultsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResultsPostResponse, error) {
+	rsp, err := c.SearchResultsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchResultsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchResultsPostWithFormdataBodyWithResponse(ctx context.Context, body SearchResultsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchResultsPostResponse, error) {
+	rsp, err := c.SearchResultsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchResultsPostResponse(rsp)
+}
+
+// SearchStartPostWithBodyWithResponse request with arbitrary body returning *SearchStartPostResponse
+func (c *ClientWithResponses) SearchStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStartPostResponse, error) {
+	rsp, err := c.SearchStartPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStartPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchStartPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStartPostResponse, error) {
+	rsp, err := c.SearchStartPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStartPostResponse(rsp)
+}
+
+// SearchStatusPostWithBodyWithResponse request with arbitrary body returning *SearchStatusPostResponse
+func (c *ClientWithResponses) SearchStatusPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStatusPostResponse, error) {
+	rsp, err := c.SearchStatusPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStatusPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchStatusPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStatusPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStatusPostResponse, error) {
+	rsp, err := c.SearchStatusPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStatusPostResponse(rsp)
+}
+
+// SearchStopPostWithBodyWithResponse request with arbitrary body returning *SearchStopPostResponse
+func (c *ClientWithResponses) SearchStopPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchStopPostResponse, error) {
+	rsp, err := c.SearchStopPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStopPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchStopPostWithFormdataBodyWithResponse(ctx context.Context, body SearchStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchStopPostResponse, error) {
+	rsp, err := c.SearchStopPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchStopPostResponse(rsp)
+}
+
+// SearchUninstallPluginPostWithBodyWithResponse request with arbitrary body returning *SearchUninstallPluginPostResponse
+func (c *ClientWithResponses) SearchUninstallPluginPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchUninstallPluginPostResponse, error) {
+	rsp, err := c.SearchUninstallPluginPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchUninstallPluginPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchUninstallPluginPostWithFormdataBodyWithResponse(ctx context.Context, body SearchUninstallPluginPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SearchUninstallPluginPostResponse, error) {
+	rsp, err := c.SearchUninstallPluginPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchUninstallPluginPostResponse(rsp)
+}
+
+// SearchUpdatePluginsPostWithResponse request returning *SearchUpdatePluginsPostResponse
+func (c *ClientWithResponses) SearchUpdatePluginsPostWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SearchUpdatePluginsPostResponse, error) {
+	rsp, err := c.SearchUpdatePluginsPost(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchUpdatePluginsPostResponse(rsp)
+}
+
+// SyncMaindataPostWithBodyWithResponse request with arbitrary body returning *SyncMaindataPostResponse
+func (c *ClientWithResponses) SyncMaindataPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncMaindataPostResponse, error) {
+	rsp, err := c.SyncMaindataPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncMaindataPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SyncMaindataPostWithFormdataBodyWithResponse(ctx context.Context, body SyncMaindataPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SyncMaindataPostResponse, error) {
+	rsp, err := c.SyncMaindataPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncMaindataPostResponse(rsp)
+}
+
+// SyncTorrentPeersPostWithBodyWithResponse request with arbitrary body returning *SyncTorrentPeersPostResponse
+func (c *ClientWithResponses) SyncTorrentPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SyncTorrentPeersPostResponse, error) {
+	rsp, err := c.SyncTorrentPeersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncTorrentPeersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) SyncTorrentPeersPostWithFormdataBodyWithResponse(ctx context.Context, body SyncTorrentPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*SyncTorrentPeersPostResponse, error) {
+	rsp, err := c.SyncTorrentPeersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSyncTorrentPeersPostResponse(rsp)
+}
+
+// TorrentsAddPostWithBodyWithResponse request with arbitrary body returning *TorrentsAddPostResponse
+func (c *ClientWithResponses) TorrentsAddPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddPostResponse, error) {
+	rsp, err := c.TorrentsAddPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddPostResponse(rsp)
+}
+
+// TorrentsAddPeersPostWithBodyWithResponse request with arbitrary body returning *TorrentsAddPeersPostResponse
+func (c *ClientWithResponses) TorrentsAddPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddPeersPostResponse, error) {
+	rsp, err := c.TorrentsAddPeersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddPeersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsAddPeersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddPeersPostResponse, error) {
+	rsp, err := c.TorrentsAddPeersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddPeersPostResponse(rsp)
+}
+
+// TorrentsAddTagsPostWithBodyWithResponse request with arbitrary body returning *TorrentsAddTagsPostResponse
+func (c *ClientWithResponses) TorrentsAddTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddTagsPostResponse, error) {
+	rsp, err := c.TorrentsAddTagsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddTagsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsAddTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddTagsPostResponse, error) {
+	rsp, err := c.TorrentsAddTagsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddTagsPostResponse(rsp)
+}
+
+// TorrentsAddTrackersPostWithBodyWithResponse request with arbitrary body returning *TorrentsAddTrackersPostResponse
+func (c *ClientWithResponses) TorrentsAddTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsAddTrackersPostResponse, error) {
+	rsp, err := c.TorrentsAddTrackersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddTrackersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsAddTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsAddTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsAddTrackersPostResponse, error) {
+	rsp, err := c.TorrentsAddTrackersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsAddTrackersPostResponse(rsp)
+}
+
+// TorrentsBottomPrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsBottomPrioPostResponse
+func (c *ClientWithResponses) TorrentsBottomPrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsBottomPrioPostResponse, error) {
+	rsp, err := c.TorrentsBottomPrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsBottomPrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsBottomPrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsBottomPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsBottomPrioPostResponse, error) {
+	rsp, err := c.TorrentsBottomPrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsBottomPrioPostResponse(rsp)
+}
+
+// TorrentsCategoriesGetWithResponse request returning *TorrentsCategoriesGetResponse
+func (c *ClientWithResponses) TorrentsCategoriesGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TorrentsCategoriesGetResponse, error) {
+	rsp, err := c.TorrentsCategoriesGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsCategoriesGetResponse(rsp)
+}
+
+// TorrentsCreateCategoryPostWithBodyWithResponse request with arbitrary body returning *TorrentsCreateCategoryPostResponse
+func (c *ClientWithResponses) TorrentsCreateCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsCreateCategoryPostResponse, error) {
+	rsp, err := c.TorrentsCreateCategoryPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsCreateCategoryPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsCreateCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsCreateCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsCreateCategoryPostResponse, error) {
+	rsp, err := c.TorrentsCreateCategoryPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsCreateCategoryPostResponse(rsp)
+}
+
+// TorrentsCreateTagsPostWithBodyWithResponse request with arbitrary body returning *TorrentsCreateTagsPostResponse
+func (c *ClientWithResponses) TorrentsCreateTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsCreateTagsPostResponse, error) {
+	rsp, err := c.TorrentsCreateTagsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsCreateTagsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsCreateTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsCreateTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsCreateTagsPostResponse, error) {
+	rsp, err := c.TorrentsCreateTagsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsCreateTagsPostResponse(rsp)
+}
+
+// TorrentsDecreasePrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsDecreasePrioPostResponse
+func (c *ClientWithResponses) TorrentsDecreasePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDecreasePrioPostResponse, error) {
+	rsp, err := c.TorrentsDecreasePrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDecreasePrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsDecreasePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDecreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDecreasePrioPostResponse, error) {
+	rsp, err := c.TorrentsDecreasePrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDecreasePrioPostResponse(rsp)
+}
+
+// TorrentsDeletePostWithBodyWithResponse request with arbitrary body returning *TorrentsDeletePostResponse
+func (c *ClientWithResponses) TorrentsDeletePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDeletePostResponse, error) {
+	rsp, err := c.TorrentsDeletePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDeletePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsDeletePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDeletePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDeletePostResponse, error) {
+	rsp, err := c.TorrentsDeletePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDeletePostResponse(rsp)
+}
+
+// TorrentsDeleteTagsPostWithBodyWithResponse request with arbitrary body returning *TorrentsDeleteTagsPostResponse
+func (c *ClientWithResponses) TorrentsDeleteTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDeleteTagsPostResponse, error) {
+	rsp, err := c.TorrentsDeleteTagsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDeleteTagsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsDeleteTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDeleteTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDeleteTagsPostResponse, error) {
+	rsp, err := c.TorrentsDeleteTagsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDeleteTagsPostResponse(rsp)
+}
+
+// TorrentsDownloadLimitPostWithBodyWithResponse request with arbitrary body returning *TorrentsDownloadLimitPostResponse
+func (c *ClientWithResponses) TorrentsDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsDownloadLimitPostResponse, error) {
+	rsp, err := c.TorrentsDownloadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDownloadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsDownloadLimitPostResponse, error) {
+	rsp, err := c.TorrentsDownloadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsDownloadLimitPostResponse(rsp)
+}
+
+// TorrentsEditCategoryPostWithBodyWithResponse request with arbitrary body returning *TorrentsEditCategoryPostResponse
+func (c *ClientWithResponses) TorrentsEditCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsEditCategoryPostResponse, error) {
+	rsp, err := c.TorrentsEditCategoryPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsEditCategoryPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsEditCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsEditCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsEditCategoryPostResponse, error) {
+	rsp, err := c.TorrentsEditCategoryPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsEditCategoryPostResponse(rsp)
+}
+
+// TorrentsEditTrackerPostWithBodyWithResponse request with arbitrary body returning *TorrentsEditTrackerPostResponse
+func (c *ClientWithResponses) TorrentsEditTrackerPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsEditTrackerPostResponse, error) {
+	rsp, err := c.TorrentsEditTrackerPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsEditTrackerPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsEditTrackerPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsEditTrackerPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsEditTrackerPostResponse, error) {
+	rsp, err := c.TorrentsEditTrackerPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsEditTrackerPostResponse(rsp)
+}
+
+// TorrentsFilePrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsFilePrioPostResponse
+func (c *ClientWithResponses) TorrentsFilePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsFilePrioPostResponse, error) {
+	rsp, err := c.TorrentsFilePrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsFilePrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsFilePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsFilePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsFilePrioPostResponse, error) {
+	rsp, err := c.TorrentsFilePrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsFilePrioPostResponse(rsp)
+}
+
+// TorrentsFilesPostWithBodyWithResponse request with arbitrary body returning *TorrentsFilesPostResponse
+func (c *ClientWithResponses) TorrentsFilesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsFilesPostResponse, error) {
+	rsp, err := c.TorrentsFilesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsFilesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsFilesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsFilesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsFilesPostResponse, error) {
+	rsp, err := c.TorrentsFilesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsFilesPostResponse(rsp)
+}
+
+// TorrentsIncreasePrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsIncreasePrioPostResponse
+func (c *ClientWithResponses) TorrentsIncreasePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsIncreasePrioPostResponse, error) {
+	rsp, err := c.TorrentsIncreasePrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsIncreasePrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsIncreasePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsIncreasePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsIncreasePrioPostResponse, error) {
+	rsp, err := c.TorrentsIncreasePrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsIncreasePrioPostResponse(rsp)
+}
+
+// TorrentsInfoPostWithBodyWithResponse request with arbitrary body returning *TorrentsInfoPostResponse
+func (c *ClientWithResponses) TorrentsInfoPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsInfoPostResponse, error) {
+	rsp, err := c.TorrentsInfoPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsInfoPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsInfoPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsInfoPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsInfoPostResponse, error) {
+	rsp, err := c.TorrentsInfoPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsInfoPostResponse(rsp)
+}
+
+// TorrentsPausePostWithBodyWithResponse request with arbitrary body returning *TorrentsPausePostResponse
+func (c *ClientWithResponses) TorrentsPausePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPausePostResponse, error) {
+	rsp, err := c.TorrentsPausePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPausePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsPausePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPausePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPausePostResponse, error) {
+	rsp, err := c.TorrentsPausePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPausePostResponse(rsp)
+}
+
+// TorrentsPieceHashesPostWithBodyWithResponse request with arbitrary body returning *TorrentsPieceHashesPostResponse
+func (c *ClientWithResponses) TorrentsPieceHashesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPieceHashesPostResponse, error) {
+	rsp, err := c.TorrentsPieceHashesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPieceHashesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsPieceHashesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPieceHashesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPieceHashesPostResponse, error) {
+	rsp, err := c.TorrentsPieceHashesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPieceHashesPostResponse(rsp)
+}
+
+// TorrentsPieceStatesPostWithBodyWithResponse request with arbitrary body returning *TorrentsPieceStatesPostResponse
+func (c *ClientWithResponses) TorrentsPieceStatesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPieceStatesPostResponse, error) {
+	rsp, err := c.TorrentsPieceStatesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPieceStatesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsPieceStatesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPieceStatesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPieceStatesPostResponse, error) {
+	rsp, err := c.TorrentsPieceStatesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPieceStatesPostResponse(rsp)
+}
+
+// TorrentsPropertiesPostWithBodyWithResponse request with arbitrary body returning *TorrentsPropertiesPostResponse
+func (c *ClientWithResponses) TorrentsPropertiesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsPropertiesPostResponse, error) {
+	rsp, err := c.TorrentsPropertiesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPropertiesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsPropertiesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsPropertiesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsPropertiesPostResponse, error) {
+	rsp, err := c.TorrentsPropertiesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsPropertiesPostResponse(rsp)
+}
+
+// TorrentsReannouncePostWithBodyWithResponse request with arbitrary body returning *TorrentsReannouncePostResponse
+func (c *ClientWithResponses) TorrentsReannouncePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsReannouncePostResponse, error) {
+	rsp, err := c.TorrentsReannouncePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsReannouncePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsReannouncePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsReannouncePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsReannouncePostResponse, error) {
+	rsp, err := c.TorrentsReannouncePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsReannouncePostResponse(rsp)
+}
+
+// TorrentsRecheckPostWithBodyWithResponse request with arbitrary body returning *TorrentsRecheckPostResponse
+func (c *ClientWithResponses) TorrentsRecheckPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRecheckPostResponse, error) {
+	rsp, err := c.TorrentsRecheckPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRecheckPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRecheckPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRecheckPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRecheckPostResponse, error) {
+	rsp, err := c.TorrentsRecheckPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRecheckPostResponse(rsp)
+}
+
+// TorrentsRemoveCategoriesPostWithBodyWithResponse request with arbitrary body returning *TorrentsRemoveCategoriesPostResponse
+func (c *ClientWithResponses) TorrentsRemoveCategoriesPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveCategoriesPostResponse, error) {
+	rsp, err := c.TorrentsRemoveCategoriesPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveCategoriesPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRemoveCategoriesPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveCategoriesPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveCategoriesPostResponse, error) {
+	rsp, err := c.TorrentsRemoveCategoriesPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveCategoriesPostResponse(rsp)
+}
+
+// TorrentsRemoveTagsPostWithBodyWithResponse request with arbitrary body returning *TorrentsRemoveTagsPostResponse
+func (c *ClientWithResponses) TorrentsRemoveTagsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveTagsPostResponse, error) {
+	rsp, err := c.TorrentsRemoveTagsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveTagsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRemoveTagsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveTagsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveTagsPostResponse, error) {
+	rsp, err := c.TorrentsRemoveTagsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveTagsPostResponse(rsp)
+}
+
+// TorrentsRemoveTrackersPostWithBodyWithResponse request with arbitrary body returning *TorrentsRemoveTrackersPostResponse
+func (c *ClientWithResponses) TorrentsRemoveTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRemoveTrackersPostResponse, error) {
+	rsp, err := c.TorrentsRemoveTrackersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveTrackersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRemoveTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRemoveTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRemoveTrackersPostResponse, error) {
+	rsp, err := c.TorrentsRemoveTrackersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRemoveTrackersPostResponse(rsp)
+}
+
+// TorrentsRenamePostWithBodyWithResponse request with arbitrary body returning *TorrentsRenamePostResponse
+func (c *ClientWithResponses) TorrentsRenamePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenamePostResponse, error) {
+	rsp, err := c.TorrentsRenamePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenamePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRenamePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenamePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenamePostResponse, error) {
+	rsp, err := c.TorrentsRenamePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenamePostResponse(rsp)
+}
+
+// TorrentsRenameFilePostWithBodyWithResponse request with arbitrary body returning *TorrentsRenameFilePostResponse
+func (c *ClientWithResponses) TorrentsRenameFilePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenameFilePostResponse, error) {
+	rsp, err := c.TorrentsRenameFilePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenameFilePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRenameFilePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenameFilePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenameFilePostResponse, error) {
+	rsp, err := c.TorrentsRenameFilePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenameFilePostResponse(rsp)
+}
+
+// TorrentsRenameFolderPostWithBodyWithResponse request with arbitrary body returning *TorrentsRenameFolderPostResponse
+func (c *ClientWithResponses) TorrentsRenameFolderPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsRenameFolderPostResponse, error) {
+	rsp, err := c.TorrentsRenameFolderPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenameFolderPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsRenameFolderPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsRenameFolderPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsRenameFolderPostResponse, error) {
+	rsp, err := c.TorrentsRenameFolderPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsRenameFolderPostResponse(rsp)
+}
+
+// TorrentsResumePostWithBodyWithResponse request with arbitrary body returning *TorrentsResumePostResponse
+func (c *ClientWithResponses) TorrentsResumePostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsResumePostResponse, error) {
+	rsp, err := c.TorrentsResumePostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsResumePostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsResumePostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsResumePostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsResumePostResponse, error) {
+	rsp, err := c.TorrentsResumePostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsResumePostResponse(rsp)
+}
+
+// TorrentsSetAutoManagementPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetAutoManagementPostResponse
+func (c *ClientWithResponses) TorrentsSetAutoManagementPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetAutoManagementPostResponse, error) {
+	rsp, err := c.TorrentsSetAutoManagementPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetAutoManagementPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetAutoManagementPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetAutoManagementPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetAutoManagementPostResponse, error) {
+	rsp, err := c.TorrentsSetAutoManagementPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetAutoManagementPostResponse(rsp)
+}
+
+// TorrentsSetCategoryPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetCategoryPostResponse
+func (c *ClientWithResponses) TorrentsSetCategoryPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetCategoryPostResponse, error) {
+	rsp, err := c.TorrentsSetCategoryPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetCategoryPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetCategoryPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetCategoryPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetCategoryPostResponse, error) {
+	rsp, err := c.TorrentsSetCategoryPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetCategoryPostResponse(rsp)
+}
+
+// TorrentsSetDownloadLimitPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetDownloadLimitPostResponse
+func (c *ClientWithResponses) TorrentsSetDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetDownloadLimitPostResponse, error) {
+	rsp, err := c.TorrentsSetDownloadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetDownloadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetDownloadLimitPostResponse, error) {
+	rsp, err := c.TorrentsSetDownloadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetDownloadLimitPostResponse(rsp)
+}
+
+// TorrentsSetForceStartPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetForceStartPostResponse
+func (c *ClientWithResponses) TorrentsSetForceStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetForceStartPostResponse, error) {
+	rsp, err := c.TorrentsSetForceStartPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetForceStartPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetForceStartPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetForceStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetForceStartPostResponse, error) {
+	rsp, err := c.TorrentsSetForceStartPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetForceStartPostResponse(rsp)
+}
+
+// TorrentsSetLocationPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetLocationPostResponse
+func (c *ClientWithResponses) TorrentsSetLocationPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetLocationPostResponse, error) {
+	rsp, err := c.TorrentsSetLocationPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetLocationPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetLocationPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetLocationPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetLocationPostResponse, error) {
+	rsp, err := c.TorrentsSetLocationPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetLocationPostResponse(rsp)
+}
+
+// TorrentsSetShareLimitsPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetShareLimitsPostResponse
+func (c *ClientWithResponses) TorrentsSetShareLimitsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetShareLimitsPostResponse, error) {
+	rsp, err := c.TorrentsSetShareLimitsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetShareLimitsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetShareLimitsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetShareLimitsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetShareLimitsPostResponse, error) {
+	rsp, err := c.TorrentsSetShareLimitsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetShareLimitsPostResponse(rsp)
+}
+
+// TorrentsSetSuperSeedingPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetSuperSeedingPostResponse
+func (c *ClientWithResponses) TorrentsSetSuperSeedingPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetSuperSeedingPostResponse, error) {
+	rsp, err := c.TorrentsSetSuperSeedingPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetSuperSeedingPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetSuperSeedingPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetSuperSeedingPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetSuperSeedingPostResponse, error) {
+	rsp, err := c.TorrentsSetSuperSeedingPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetSuperSeedingPostResponse(rsp)
+}
+
+// TorrentsSetUploadLimitPostWithBodyWithResponse request with arbitrary body returning *TorrentsSetUploadLimitPostResponse
+func (c *ClientWithResponses) TorrentsSetUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsSetUploadLimitPostResponse, error) {
+	rsp, err := c.TorrentsSetUploadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetUploadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsSetUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsSetUploadLimitPostResponse, error) {
+	rsp, err := c.TorrentsSetUploadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsSetUploadLimitPostResponse(rsp)
+}
+
+// TorrentsStartPostWithBodyWithResponse request with arbitrary body returning *TorrentsStartPostResponse
+func (c *ClientWithResponses) TorrentsStartPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsStartPostResponse, error) {
+	rsp, err := c.TorrentsStartPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsStartPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsStartPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsStartPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsStartPostResponse, error) {
+	rsp, err := c.TorrentsStartPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsStartPostResponse(rsp)
+}
+
+// TorrentsStopPostWithBodyWithResponse request with arbitrary body returning *TorrentsStopPostResponse
+func (c *ClientWithResponses) TorrentsStopPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsStopPostResponse, error) {
+	rsp, err := c.TorrentsStopPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsStopPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsStopPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsStopPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsStopPostResponse, error) {
+	rsp, err := c.TorrentsStopPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsStopPostResponse(rsp)
+}
+
+// TorrentsTagsGetWithResponse request returning *TorrentsTagsGetResponse
+func (c *ClientWithResponses) TorrentsTagsGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TorrentsTagsGetResponse, error) {
+	rsp, err := c.TorrentsTagsGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsTagsGetResponse(rsp)
+}
+
+// TorrentsToggleFirstLastPiecePrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsToggleFirstLastPiecePrioPostResponse
+func (c *ClientWithResponses) TorrentsToggleFirstLastPiecePrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsToggleFirstLastPiecePrioPostResponse, error) {
+	rsp, err := c.TorrentsToggleFirstLastPiecePrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsToggleFirstLastPiecePrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsToggleFirstLastPiecePrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsToggleFirstLastPiecePrioPostResponse, error) {
+	rsp, err := c.TorrentsToggleFirstLastPiecePrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsToggleFirstLastPiecePrioPostResponse(rsp)
+}
+
+// TorrentsToggleSequentialDownloadPostWithBodyWithResponse request with arbitrary body returning *TorrentsToggleSequentialDownloadPostResponse
+func (c *ClientWithResponses) TorrentsToggleSequentialDownloadPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsToggleSequentialDownloadPostResponse, error) {
+	rsp, err := c.TorrentsToggleSequentialDownloadPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsToggleSequentialDownloadPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsToggleSequentialDownloadPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsToggleSequentialDownloadPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsToggleSequentialDownloadPostResponse, error) {
+	rsp, err := c.TorrentsToggleSequentialDownloadPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsToggleSequentialDownloadPostResponse(rsp)
+}
+
+// TorrentsTopPrioPostWithBodyWithResponse request with arbitrary body returning *TorrentsTopPrioPostResponse
+func (c *ClientWithResponses) TorrentsTopPrioPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsTopPrioPostResponse, error) {
+	rsp, err := c.TorrentsTopPrioPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsTopPrioPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsTopPrioPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsTopPrioPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsTopPrioPostResponse, error) {
+	rsp, err := c.TorrentsTopPrioPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsTopPrioPostResponse(rsp)
+}
+
+// TorrentsTrackersPostWithBodyWithResponse request with arbitrary body returning *TorrentsTrackersPostResponse
+func (c *ClientWithResponses) TorrentsTrackersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsTrackersPostResponse, error) {
+	rsp, err := c.TorrentsTrackersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsTrackersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsTrackersPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsTrackersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsTrackersPostResponse, error) {
+	rsp, err := c.TorrentsTrackersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsTrackersPostResponse(rsp)
+}
+
+// TorrentsUploadLimitPostWithBodyWithResponse request with arbitrary body returning *TorrentsUploadLimitPostResponse
+func (c *ClientWithResponses) TorrentsUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentsUploadLimitPostResponse, error) {
+	rsp, err := c.TorrentsUploadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsUploadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentsUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentsUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentsUploadLimitPostResponse, error) {
+	rsp, err := c.TorrentsUploadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentsUploadLimitPostResponse(rsp)
+}
+
+// TorrentWebseedsPostWithBodyWithResponse request with arbitrary body returning *TorrentWebseedsPostResponse
+func (c *ClientWithResponses) TorrentWebseedsPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TorrentWebseedsPostResponse, error) {
+	rsp, err := c.TorrentWebseedsPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentWebseedsPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TorrentWebseedsPostWithFormdataBodyWithResponse(ctx context.Context, body TorrentWebseedsPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TorrentWebseedsPostResponse, error) {
+	rsp, err := c.TorrentWebseedsPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTorrentWebseedsPostResponse(rsp)
+}
+
+// TransferBanPeersPostWithBodyWithResponse request with arbitrary body returning *TransferBanPeersPostResponse
+func (c *ClientWithResponses) TransferBanPeersPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferBanPeersPostResponse, error) {
+	rsp, err := c.TransferBanPeersPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferBanPeersPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferBanPeersPostWithFormdataBodyWithResponse(ctx context.Context, body TransferBanPeersPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferBanPeersPostResponse, error) {
+	rsp, err := c.TransferBanPeersPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferBanPeersPostResponse(rsp)
+}
+
+// TransferDownloadLimitGetWithResponse request returning *TransferDownloadLimitGetResponse
+func (c *ClientWithResponses) TransferDownloadLimitGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferDownloadLimitGetResponse, error) {
+	rsp, err := c.TransferDownloadLimitGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferDownloadLimitGetResponse(rsp)
+}
+
+// TransferInfoGetWithResponse request returning *TransferInfoGetResponse
+func (c *ClientWithResponses) TransferInfoGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferInfoGetResponse, error) {
+	rsp, err := c.TransferInfoGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferInfoGetResponse(rsp)
+}
+
+// TransferSetDownloadLimitPostWithBodyWithResponse request with arbitrary body returning *TransferSetDownloadLimitPostResponse
+func (c *ClientWithResponses) TransferSetDownloadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferSetDownloadLimitPostResponse, error) {
+	rsp, err := c.TransferSetDownloadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferSetDownloadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferSetDownloadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TransferSetDownloadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferSetDownloadLimitPostResponse, error) {
+	rsp, err := c.TransferSetDownloadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferSetDownloadLimitPostResponse(rsp)
+}
+
+// TransferSetUploadLimitPostWithBodyWithResponse request with arbitrary body returning *TransferSetUploadLimitPostResponse
+func (c *ClientWithResponses) TransferSetUploadLimitPostWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransferSetUploadLimitPostResponse, error) {
+	rsp, err := c.TransferSetUploadLimitPostWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferSetUploadLimitPostResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransferSetUploadLimitPostWithFormdataBodyWithResponse(ctx context.Context, body TransferSetUploadLimitPostFormdataRequestBody, reqEditors ...RequestEditorFn) (*TransferSetUploadLimitPostResponse, error) {
+	rsp, err := c.TransferSetUploadLimitPostWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferSetUploadLimitPostResponse(rsp)
+}
+
+// TransferSpeedLimitsModeGetWithResponse request returning *TransferSpeedLimitsModeGetResponse
+func (c *ClientWithResponses) TransferSpeedLimitsModeGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferSpeedLimitsModeGetResponse, error) {
+	rsp, err := c.TransferSpeedLimitsModeGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferSpeedLimitsModeGetResponse(rsp)
+}
+
+// TransferToggleSpeedLimitsModeGetWithResponse request returning *TransferToggleSpeedLimitsModeGetResponse
+func (c *ClientWithResponses) TransferToggleSpeedLimitsModeGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferToggleSpeedLimitsModeGetResponse, error) {
+	rsp, err := c.TransferToggleSpeedLimitsModeGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferToggleSpeedLimitsModeGetResponse(rsp)
+}
+
+// TransferUploadLimitGetWithResponse request returning *TransferUploadLimitGetResponse
+func (c *ClientWithResponses) TransferUploadLimitGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*TransferUploadLimitGetResponse, error) {
+	rsp, err := c.TransferUploadLimitGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransferUploadLimitGetResponse(rsp)
+}
+
+// ParseAppBuildInfoGetResponse parses an HTTP response from a AppBuildInfoGetWithResponse call
+func ParseAppBuildInfoGetResponse(rsp *http.Response) (*AppBuildInfoGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppBuildInfoGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BuildInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAppDefaultSavePathGetResponse parses an HTTP response from a AppDefaultSavePathGetWithResponse call
+func ParseAppDefaultSavePathGetResponse(rsp *http.Response) (*AppDefaultSavePathGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppDefaultSavePathGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAppPreferencesGetResponse parses an HTTP response from a AppPreferencesGetWithResponse call
+func ParseAppPreferencesGetResponse(rsp *http.Response) (*AppPreferencesGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppPreferencesGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Preferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAppSetPreferencesPostResponse parses an HTTP response from a AppSetPreferencesPostWithResponse call
+func ParseAppSetPreferencesPostResponse(rsp *http.Response) (*AppSetPreferencesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppSetPreferencesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAppShutdownGetResponse parses an HTTP response from a AppShutdownGetWithResponse call
+func ParseAppShutdownGetResponse(rsp *http.Response) (*AppShutdownGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppShutdownGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAppVersionGetResponse parses an HTTP response from a AppVersionGetWithResponse call
+func ParseAppVersionGetResponse(rsp *http.Response) (*AppVersionGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppVersionGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAppWebapiVersionGetResponse parses an HTTP response from a AppWebapiVersionGetWithResponse call
+func ParseAppWebapiVersionGetResponse(rsp *http.Response) (*AppWebapiVersionGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AppWebapiVersionGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAuthLoginPostResponse parses an HTTP response from a AuthLoginPostWithResponse call
+func ParseAuthLoginPostResponse(rsp *http.Response) (*AuthLoginPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthLoginPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAuthLogoutPostResponse parses an HTTP response from a AuthLogoutPostWithResponse call
+func ParseAuthLogoutPostResponse(rsp *http.Response) (*AuthLogoutPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AuthLogoutPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseLogMainPostResponse parses an HTTP response from a LogMainPostWithResponse call
+func ParseLogMainPostResponse(rsp *http.Response) (*LogMainPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogMainPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MainLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogPeersPostResponse parses an HTTP response from a LogPeersPostWithResponse call
+func ParseLogPeersPostResponse(rsp *http.Response) (*LogPeersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogPeersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PeersLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRssAddFeedPostResponse parses an HTTP response from a RssAddFeedPostWithResponse call
+func ParseRssAddFeedPostResponse(rsp *http.Response) (*RssAddFeedPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssAddFeedPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssAddFolderPostResponse parses an HTTP response from a RssAddFolderPostWithResponse call
+func ParseRssAddFolderPostResponse(rsp *http.Response) (*RssAddFolderPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssAddFolderPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssItemsPostResponse parses an HTTP response from a RssItemsPostWithResponse call
+func ParseRssItemsPostResponse(rsp *http.Response) (*RssItemsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssItemsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRssMarkAsReadPostResponse parses an HTTP response from a RssMarkAsReadPostWithResponse call
+func ParseRssMarkAsReadPostResponse(rsp *http.Response) (*RssMarkAsReadPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssMarkAsReadPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssMatchingArticlesPostResponse parses an HTTP response from a RssMatchingArticlesPostWithResponse call
+func ParseRssMatchingArticlesPostResponse(rsp *http.Response) (*RssMatchingArticlesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssMatchingArticlesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string][]string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRssMoveItemPostResponse parses an HTTP response from a RssMoveItemPostWithResponse call
+func ParseRssMoveItemPostResponse(rsp *http.Response) (*RssMoveItemPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssMoveItemPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssRefreshItemPostResponse parses an HTTP response from a RssRefreshItemPostWithResponse call
+func ParseRssRefreshItemPostResponse(rsp *http.Response) (*RssRefreshItemPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssRefreshItemPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssRemoveItemPostResponse parses an HTTP response from a RssRemoveItemPostWithResponse call
+func ParseRssRemoveItemPostResponse(rsp *http.Response) (*RssRemoveItemPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssRemoveItemPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssRemoveRulePostResponse parses an HTTP response from a RssRemoveRulePostWithResponse call
+func ParseRssRemoveRulePostResponse(rsp *http.Response) (*RssRemoveRulePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssRemoveRulePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssRenameRulePostResponse parses an HTTP response from a RssRenameRulePostWithResponse call
+func ParseRssRenameRulePostResponse(rsp *http.Response) (*RssRenameRulePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssRenameRulePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseRssRulesGetResponse parses an HTTP response from a RssRulesGetWithResponse call
+func ParseRssRulesGetResponse(rsp *http.Response) (*RssRulesGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssRulesGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]RssRuleDef
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRssSetRulePostResponse parses an HTTP response from a RssSetRulePostWithResponse call
+func ParseRssSetRulePostResponse(rsp *http.Response) (*RssSetRulePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RssSetRulePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchDeletePostResponse parses an HTTP response from a SearchDeletePostWithResponse call
+func ParseSearchDeletePostResponse(rsp *http.Response) (*SearchDeletePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchDeletePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchEnablePluginPostResponse parses an HTTP response from a SearchEnablePluginPostWithResponse call
+func ParseSearchEnablePluginPostResponse(rsp *http.Response) (*SearchEnablePluginPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchEnablePluginPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchInstallPluginPostResponse parses an HTTP response from a SearchInstallPluginPostWithResponse call
+func ParseSearchInstallPluginPostResponse(rsp *http.Response) (*SearchInstallPluginPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchInstallPluginPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchPluginsGetResponse parses an HTTP response from a SearchPluginsGetWithResponse call
+func ParseSearchPluginsGetResponse(rsp *http.Response) (*SearchPluginsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchPluginsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SearchPlugin
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchResultsPostResponse parses an HTTP response from a SearchResultsPostWithResponse call
+func ParseSearchResultsPostResponse(rsp *http.Response) (*SearchResultsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchResultsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchStartPostResponse parses an HTTP response from a SearchStartPostWithResponse call
+func ParseSearchStartPostResponse(rsp *http.Response) (*SearchStartPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchStartPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchJob
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchStatusPostResponse parses an HTTP response from a SearchStatusPostWithResponse call
+func ParseSearchStatusPostResponse(rsp *http.Response) (*SearchStatusPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchStatusPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SearchJobStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchStopPostResponse parses an HTTP response from a SearchStopPostWithResponse call
+func ParseSearchStopPostResponse(rsp *http.Response) (*SearchStopPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchStopPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchUninstallPluginPostResponse parses an HTTP response from a SearchUninstallPluginPostWithResponse call
+func ParseSearchUninstallPluginPostResponse(rsp *http.Response) (*SearchUninstallPluginPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchUninstallPluginPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSearchUpdatePluginsPostResponse parses an HTTP response from a SearchUpdatePluginsPostWithResponse call
+func ParseSearchUpdatePluginsPostResponse(rsp *http.Response) (*SearchUpdatePluginsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchUpdatePluginsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseSyncMaindataPostResponse parses an HTTP response from a SyncMaindataPostWithResponse call
+func ParseSyncMaindataPostResponse(rsp *http.Response) (*SyncMaindataPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncMaindataPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MainData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSyncTorrentPeersPostResponse parses an HTTP response from a SyncTorrentPeersPostWithResponse call
+func ParseSyncTorrentPeersPostResponse(rsp *http.Response) (*SyncTorrentPeersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SyncTorrentPeersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TorrentPeers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsAddPostResponse parses an HTTP response from a TorrentsAddPostWithResponse call
+func ParseTorrentsAddPostResponse(rsp *http.Response) (*TorrentsAddPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsAddPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsAddPeersPostResponse parses an HTTP response from a TorrentsAddPeersPostWithResponse call
+func ParseTorrentsAddPeersPostResponse(rsp *http.Response) (*TorrentsAddPeersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsAddPeersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsAddTagsPostResponse parses an HTTP response from a TorrentsAddTagsPostWithResponse call
+func ParseTorrentsAddTagsPostResponse(rsp *http.Response) (*TorrentsAddTagsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsAddTagsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsAddTrackersPostResponse parses an HTTP response from a TorrentsAddTrackersPostWithResponse call
+func ParseTorrentsAddTrackersPostResponse(rsp *http.Response) (*TorrentsAddTrackersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsAddTrackersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsBottomPrioPostResponse parses an HTTP response from a TorrentsBottomPrioPostWithResponse call
+func ParseTorrentsBottomPrioPostResponse(rsp *http.Response) (*TorrentsBottomPrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsBottomPrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsCategoriesGetResponse parses an HTTP response from a TorrentsCategoriesGetWithResponse call
+func ParseTorrentsCategoriesGetResponse(rsp *http.Response) (*TorrentsCategoriesGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsCategoriesGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest map[string]TorrentsCategory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsCreateCategoryPostResponse parses an HTTP response from a TorrentsCreateCategoryPostWithResponse call
+func ParseTorrentsCreateCategoryPostResponse(rsp *http.Response) (*TorrentsCreateCategoryPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsCreateCategoryPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsCreateTagsPostResponse parses an HTTP response from a TorrentsCreateTagsPostWithResponse call
+func ParseTorrentsCreateTagsPostResponse(rsp *http.Response) (*TorrentsCreateTagsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsCreateTagsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsDecreasePrioPostResponse parses an HTTP response from a TorrentsDecreasePrioPostWithResponse call
+func ParseTorrentsDecreasePrioPostResponse(rsp *http.Response) (*TorrentsDecreasePrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsDecreasePrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsDeletePostResponse parses an HTTP response from a TorrentsDeletePostWithResponse call
+func ParseTorrentsDeletePostResponse(rsp *http.Response) (*TorrentsDeletePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsDeletePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsDeleteTagsPostResponse parses an HTTP response from a TorrentsDeleteTagsPostWithResponse call
+func ParseTorrentsDeleteTagsPostResponse(rsp *http.Response) (*TorrentsDeleteTagsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsDeleteTagsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsDownloadLimitPostResponse parses an HTTP response from a TorrentsDownloadLimitPostWithResponse call
+func ParseTorrentsDownloadLimitPostResponse(rsp *http.Response) (*TorrentsDownloadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsDownloadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TorrentsLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsEditCategoryPostResponse parses an HTTP response from a TorrentsEditCategoryPostWithResponse call
+func ParseTorrentsEditCategoryPostResponse(rsp *http.Response) (*TorrentsEditCategoryPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsEditCategoryPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsEditTrackerPostResponse parses an HTTP response from a TorrentsEditTrackerPostWithResponse call
+func ParseTorrentsEditTrackerPostResponse(rsp *http.Response) (*TorrentsEditTrackerPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsEditTrackerPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsFilePrioPostResponse parses an HTTP response from a TorrentsFilePrioPostWithResponse call
+func ParseTorrentsFilePrioPostResponse(rsp *http.Response) (*TorrentsFilePrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsFilePrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsFilesPostResponse parses an HTTP response from a TorrentsFilesPostWithResponse call
+func ParseTorrentsFilesPostResponse(rsp *http.Response) (*TorrentsFilesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsFilesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TorrentsFiles
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsIncreasePrioPostResponse parses an HTTP response from a TorrentsIncreasePrioPostWithResponse call
+func ParseTorrentsIncreasePrioPostResponse(rsp *http.Response) (*TorrentsIncreasePrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsIncreasePrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsInfoPostResponse parses an HTTP response from a TorrentsInfoPostWithResponse call
+func ParseTorrentsInfoPostResponse(rsp *http.Response) (*TorrentsInfoPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsInfoPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TorrentInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsPausePostResponse parses an HTTP response from a TorrentsPausePostWithResponse call
+func ParseTorrentsPausePostResponse(rsp *http.Response) (*TorrentsPausePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsPausePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsPieceHashesPostResponse parses an HTTP response from a TorrentsPieceHashesPostWithResponse call
+func ParseTorrentsPieceHashesPostResponse(rsp *http.Response) (*TorrentsPieceHashesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsPieceHashesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsPieceStatesPostResponse parses an HTTP response from a TorrentsPieceStatesPostWithResponse call
+func ParseTorrentsPieceStatesPostResponse(rsp *http.Response) (*TorrentsPieceStatesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsPieceStatesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TorrentsPieceStatesPost200
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsPropertiesPostResponse parses an HTTP response from a TorrentsPropertiesPostWithResponse call
+func ParseTorrentsPropertiesPostResponse(rsp *http.Response) (*TorrentsPropertiesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsPropertiesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TorrentsProperties
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsReannouncePostResponse parses an HTTP response from a TorrentsReannouncePostWithResponse call
+func ParseTorrentsReannouncePostResponse(rsp *http.Response) (*TorrentsReannouncePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsReannouncePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRecheckPostResponse parses an HTTP response from a TorrentsRecheckPostWithResponse call
+func ParseTorrentsRecheckPostResponse(rsp *http.Response) (*TorrentsRecheckPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRecheckPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRemoveCategoriesPostResponse parses an HTTP response from a TorrentsRemoveCategoriesPostWithResponse call
+func ParseTorrentsRemoveCategoriesPostResponse(rsp *http.Response) (*TorrentsRemoveCategoriesPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRemoveCategoriesPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRemoveTagsPostResponse parses an HTTP response from a TorrentsRemoveTagsPostWithResponse call
+func ParseTorrentsRemoveTagsPostResponse(rsp *http.Response) (*TorrentsRemoveTagsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRemoveTagsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRemoveTrackersPostResponse parses an HTTP response from a TorrentsRemoveTrackersPostWithResponse call
+func ParseTorrentsRemoveTrackersPostResponse(rsp *http.Response) (*TorrentsRemoveTrackersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRemoveTrackersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRenamePostResponse parses an HTTP response from a TorrentsRenamePostWithResponse call
+func ParseTorrentsRenamePostResponse(rsp *http.Response) (*TorrentsRenamePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRenamePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRenameFilePostResponse parses an HTTP response from a TorrentsRenameFilePostWithResponse call
+func ParseTorrentsRenameFilePostResponse(rsp *http.Response) (*TorrentsRenameFilePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRenameFilePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsRenameFolderPostResponse parses an HTTP response from a TorrentsRenameFolderPostWithResponse call
+func ParseTorrentsRenameFolderPostResponse(rsp *http.Response) (*TorrentsRenameFolderPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsRenameFolderPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsResumePostResponse parses an HTTP response from a TorrentsResumePostWithResponse call
+func ParseTorrentsResumePostResponse(rsp *http.Response) (*TorrentsResumePostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsResumePostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetAutoManagementPostResponse parses an HTTP response from a TorrentsSetAutoManagementPostWithResponse call
+func ParseTorrentsSetAutoManagementPostResponse(rsp *http.Response) (*TorrentsSetAutoManagementPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetAutoManagementPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetCategoryPostResponse parses an HTTP response from a TorrentsSetCategoryPostWithResponse call
+func ParseTorrentsSetCategoryPostResponse(rsp *http.Response) (*TorrentsSetCategoryPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetCategoryPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetDownloadLimitPostResponse parses an HTTP response from a TorrentsSetDownloadLimitPostWithResponse call
+func ParseTorrentsSetDownloadLimitPostResponse(rsp *http.Response) (*TorrentsSetDownloadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetDownloadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetForceStartPostResponse parses an HTTP response from a TorrentsSetForceStartPostWithResponse call
+func ParseTorrentsSetForceStartPostResponse(rsp *http.Response) (*TorrentsSetForceStartPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetForceStartPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetLocationPostResponse parses an HTTP response from a TorrentsSetLocationPostWithResponse call
+func ParseTorrentsSetLocationPostResponse(rsp *http.Response) (*TorrentsSetLocationPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetLocationPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetShareLimitsPostResponse parses an HTTP response from a TorrentsSetShareLimitsPostWithResponse call
+func ParseTorrentsSetShareLimitsPostResponse(rsp *http.Response) (*TorrentsSetShareLimitsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetShareLimitsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetSuperSeedingPostResponse parses an HTTP response from a TorrentsSetSuperSeedingPostWithResponse call
+func ParseTorrentsSetSuperSeedingPostResponse(rsp *http.Response) (*TorrentsSetSuperSeedingPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetSuperSeedingPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsSetUploadLimitPostResponse parses an HTTP response from a TorrentsSetUploadLimitPostWithResponse call
+func ParseTorrentsSetUploadLimitPostResponse(rsp *http.Response) (*TorrentsSetUploadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsSetUploadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsStartPostResponse parses an HTTP response from a TorrentsStartPostWithResponse call
+func ParseTorrentsStartPostResponse(rsp *http.Response) (*TorrentsStartPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsStartPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsStopPostResponse parses an HTTP response from a TorrentsStopPostWithResponse call
+func ParseTorrentsStopPostResponse(rsp *http.Response) (*TorrentsStopPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsStopPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsTagsGetResponse parses an HTTP response from a TorrentsTagsGetWithResponse call
+func ParseTorrentsTagsGetResponse(rsp *http.Response) (*TorrentsTagsGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsTagsGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsToggleFirstLastPiecePrioPostResponse parses an HTTP response from a TorrentsToggleFirstLastPiecePrioPostWithResponse call
+func ParseTorrentsToggleFirstLastPiecePrioPostResponse(rsp *http.Response) (*TorrentsToggleFirstLastPiecePrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsToggleFirstLastPiecePrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsToggleSequentialDownloadPostResponse parses an HTTP response from a TorrentsToggleSequentialDownloadPostWithResponse call
+func ParseTorrentsToggleSequentialDownloadPostResponse(rsp *http.Response) (*TorrentsToggleSequentialDownloadPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsToggleSequentialDownloadPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsTopPrioPostResponse parses an HTTP response from a TorrentsTopPrioPostWithResponse call
+func ParseTorrentsTopPrioPostResponse(rsp *http.Response) (*TorrentsTopPrioPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsTopPrioPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsTrackersPostResponse parses an HTTP response from a TorrentsTrackersPostWithResponse call
+func ParseTorrentsTrackersPostResponse(rsp *http.Response) (*TorrentsTrackersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsTrackersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TorrentsTrackers
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentsUploadLimitPostResponse parses an HTTP response from a TorrentsUploadLimitPostWithResponse call
+func ParseTorrentsUploadLimitPostResponse(rsp *http.Response) (*TorrentsUploadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentsUploadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TorrentsLimit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTorrentWebseedsPostResponse parses an HTTP response from a TorrentWebseedsPostWithResponse call
+func ParseTorrentWebseedsPostResponse(rsp *http.Response) (*TorrentWebseedsPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TorrentWebseedsPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TorrentsWebseeds
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransferBanPeersPostResponse parses an HTTP response from a TransferBanPeersPostWithResponse call
+func ParseTransferBanPeersPostResponse(rsp *http.Response) (*TransferBanPeersPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferBanPeersPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferDownloadLimitGetResponse parses an HTTP response from a TransferDownloadLimitGetWithResponse call
+func ParseTransferDownloadLimitGetResponse(rsp *http.Response) (*TransferDownloadLimitGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferDownloadLimitGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferInfoGetResponse parses an HTTP response from a TransferInfoGetWithResponse call
+func ParseTransferInfoGetResponse(rsp *http.Response) (*TransferInfoGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferInfoGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTransferSetDownloadLimitPostResponse parses an HTTP response from a TransferSetDownloadLimitPostWithResponse call
+func ParseTransferSetDownloadLimitPostResponse(rsp *http.Response) (*TransferSetDownloadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferSetDownloadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferSetUploadLimitPostResponse parses an HTTP response from a TransferSetUploadLimitPostWithResponse call
+func ParseTransferSetUploadLimitPostResponse(rsp *http.Response) (*TransferSetUploadLimitPostResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferSetUploadLimitPostResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferSpeedLimitsModeGetResponse parses an HTTP response from a TransferSpeedLimitsModeGetWithResponse call
+func ParseTransferSpeedLimitsModeGetResponse(rsp *http.Response) (*TransferSpeedLimitsModeGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferSpeedLimitsModeGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferToggleSpeedLimitsModeGetResponse parses an HTTP response from a TransferToggleSpeedLimitsModeGetWithResponse call
+func ParseTransferToggleSpeedLimitsModeGetResponse(rsp *http.Response) (*TransferToggleSpeedLimitsModeGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferToggleSpeedLimitsModeGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseTransferUploadLimitGetResponse parses an HTTP response from a TransferUploadLimitGetWithResponse call
+func ParseTransferUploadLimitGetResponse(rsp *http.Response) (*TransferUploadLimitGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TransferUploadLimitGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
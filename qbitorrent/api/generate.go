@@ -0,0 +1,7 @@
+// Package api is the qbitorrent WebUI API client generated from the local
+// spec, the qbitorrent package wraps it with sessions and version
+// differences.
+package api
+
+//go:generate go tool oapi-codegen -config models.config.yaml ../specs/v2.11.2-local/openapi.yaml
+//go:generate go tool oapi-codegen -config client.config.yaml ../specs/v2.11.2-local/openapi.yaml
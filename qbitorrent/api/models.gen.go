@@ -1,7 +1,7 @@
-// Package qbitorrent provides primitives to interact with the openapi HTTP API.
+// Package api provides primitives to interact with the openapi HTTP API.
 //
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
-package qbitorrent
+package api
 
 import (
 	"encoding/json"
@@ -46,6 +46,12 @@ const (
 	AddTorrentsCommonSkipCheckingTrue  AddTorrentsCommonSkipChecking = "true"
 )
 
+// Defines values for AddTorrentsCommonStopped.
+const (
+	AddTorrentsCommonStoppedFalse AddTorrentsCommonStopped = "false"
+	AddTorrentsCommonStoppedTrue  AddTorrentsCommonStopped = "true"
+)
+
 // Defines values for AddTorrentsFilesFirstLastPiecePrio.
 const (
 	AddTorrentsFilesFirstLastPiecePrioFalse AddTorrentsFilesFirstLastPiecePrio = "false"
@@ -77,6 +83,12 @@ const (
 	AddTorrentsFilesSkipCheckingTrue  AddTorrentsFilesSkipChecking = "true"
 )
 
+// Defines values for AddTorrentsFilesStopped.
+const (
+	AddTorrentsFilesStoppedFalse AddTorrentsFilesStopped = "false"
+	AddTorrentsFilesStoppedTrue  AddTorrentsFilesStopped = "true"
+)
+
 // Defines values for AddTorrentsURLsFirstLastPiecePrio.
 const (
 	AddTorrentsURLsFirstLastPiecePrioFalse AddTorrentsURLsFirstLastPiecePrio = "false"
@@ -104,8 +116,14 @@ const (
 
 // Defines values for AddTorrentsURLsSkipChecking.
 const (
-	False AddTorrentsURLsSkipChecking = "false"
-	True  AddTorrentsURLsSkipChecking = "true"
+	AddTorrentsURLsSkipCheckingFalse AddTorrentsURLsSkipChecking = "false"
+	AddTorrentsURLsSkipCheckingTrue  AddTorrentsURLsSkipChecking = "true"
+)
+
+// Defines values for AddTorrentsURLsStopped.
+const (
+	AddTorrentsURLsStoppedFalse AddTorrentsURLsStopped = "false"
+	AddTorrentsURLsStoppedTrue  AddTorrentsURLsStopped = "true"
 )
 
 // Defines values for MainLogType.
@@ -299,6 +317,8 @@ const (
 	TorrentInfoStateQueuedUP           TorrentInfoState = "queuedUP"
 	TorrentInfoStateStalledDL          TorrentInfoState = "stalledDL"
 	TorrentInfoStateStalledUP          TorrentInfoState = "stalledUP"
+	TorrentInfoStateStoppedDL          TorrentInfoState = "stoppedDL"
+	TorrentInfoStateStoppedUP          TorrentInfoState = "stoppedUP"
 	TorrentInfoStateUnknown            TorrentInfoState = "unknown"
 	TorrentInfoStateUploading          TorrentInfoState = "uploading"
 )
@@ -338,10 +358,12 @@ const (
 	TorrentsInfoPostFormdataBodyFilterInactive           TorrentsInfoPostFormdataBodyFilter = "inactive"
 	TorrentsInfoPostFormdataBodyFilterPaused             TorrentsInfoPostFormdataBodyFilter = "paused"
 	TorrentsInfoPostFormdataBodyFilterResumed            TorrentsInfoPostFormdataBodyFilter = "resumed"
+	TorrentsInfoPostFormdataBodyFilterRunning            TorrentsInfoPostFormdataBodyFilter = "running"
 	TorrentsInfoPostFormdataBodyFilterSeeding            TorrentsInfoPostFormdataBodyFilter = "seeding"
 	TorrentsInfoPostFormdataBodyFilterStalled            TorrentsInfoPostFormdataBodyFilter = "stalled"
 	TorrentsInfoPostFormdataBodyFilterStalledDownloading TorrentsInfoPostFormdataBodyFilter = "stalled_downloading"
 	TorrentsInfoPostFormdataBodyFilterStalledUploading   TorrentsInfoPostFormdataBodyFilter = "stalled_uploading"
+	TorrentsInfoPostFormdataBodyFilterStopped            TorrentsInfoPostFormdataBodyFilter = "stopped"
 )
 
 // AddTorrentsCommon defines model for AddTorrentsCommon.
@@ -361,7 +383,7 @@ type AddTorrentsCommon struct {
 	// FirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 	FirstLastPiecePrio *AddTorrentsCommonFirstLastPiecePrio `json:"firstLastPiecePrio,omitempty"`
 
-	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default)
+	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 	Paused *AddTorrentsCommonPaused `json:"paused,omitempty"`
 
 	// RatioLimit Set torrent share ratio limit
@@ -385,8 +407,11 @@ type AddTorrentsCommon struct {
 	// SkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 	SkipChecking *AddTorrentsCommonSkipChecking `json:"skip_checking,omitempty"`
 
+	// Stopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+	Stopped *AddTorrentsCommonStopped `json:"stopped,omitempty"`
+
 	// Tags Tags for the torrent, split by ','
-	Tags *[]string `json:"tags,omitempty"`
+	Tags *string `json:"tags,omitempty"`
 
 	// UpLimit Set torrent upload speed limit. Unit in bytes/second
 	UpLimit *int64 `json:"upLimit,omitempty"`
@@ -395,7 +420,7 @@ type AddTorrentsCommon struct {
 // AddTorrentsCommonFirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 type AddTorrentsCommonFirstLastPiecePrio string
 
-// AddTorrentsCommonPaused Add torrents in the paused state. Possible values are `true`, `false` (default)
+// AddTorrentsCommonPaused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 type AddTorrentsCommonPaused string
 
 // AddTorrentsCommonRootFolder Create the root folder. Possible values are `true`, `false`, unset (default)
@@ -407,6 +432,9 @@ type AddTorrentsCommonSequentialDownload string
 // AddTorrentsCommonSkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 type AddTorrentsCommonSkipChecking string
 
+// AddTorrentsCommonStopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+type AddTorrentsCommonStopped string
+
 // AddTorrentsFiles defines model for AddTorrentsFiles.
 type AddTorrentsFiles struct {
 	// AutoTMM Whether Automatic Torrent Management should be used
@@ -424,7 +452,7 @@ type AddTorrentsFiles struct {
 	// FirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 	FirstLastPiecePrio *AddTorrentsFilesFirstLastPiecePrio `json:"firstLastPiecePrio,omitempty"`
 
-	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default)
+	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 	Paused *AddTorrentsFilesPaused `json:"paused,omitempty"`
 
 	// RatioLimit Set torrent share ratio limit
@@ -448,8 +476,11 @@ type AddTorrentsFiles struct {
 	// SkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 	SkipChecking *AddTorrentsFilesSkipChecking `json:"skip_checking,omitempty"`
 
+	// Stopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+	Stopped *AddTorrentsFilesStopped `json:"stopped,omitempty"`
+
 	// Tags Tags for the torrent, split by ','
-	Tags *[]string `json:"tags,omitempty"`
+	Tags *string `json:"tags,omitempty"`
 
 	// Torrents Raw data of torrent file. `torrents` can be presented multiple times.
 	Torrents *[]openapi_types.File `json:"torrents,omitempty"`
@@ -461,7 +492,7 @@ type AddTorrentsFiles struct {
 // AddTorrentsFilesFirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 type AddTorrentsFilesFirstLastPiecePrio string
 
-// AddTorrentsFilesPaused Add torrents in the paused state. Possible values are `true`, `false` (default)
+// AddTorrentsFilesPaused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 type AddTorrentsFilesPaused string
 
 // AddTorrentsFilesRootFolder Create the root folder. Possible values are `true`, `false`, unset (default)
@@ -473,6 +504,9 @@ type AddTorrentsFilesSequentialDownload string
 // AddTorrentsFilesSkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 type AddTorrentsFilesSkipChecking string
 
+// AddTorrentsFilesStopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+type AddTorrentsFilesStopped string
+
 // AddTorrentsURLs defines model for AddTorrentsURLs.
 type AddTorrentsURLs struct {
 	// AutoTMM Whether Automatic Torrent Management should be used
@@ -490,7 +524,7 @@ type AddTorrentsURLs struct {
 	// FirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 	FirstLastPiecePrio *AddTorrentsURLsFirstLastPiecePrio `json:"firstLastPiecePrio,omitempty"`
 
-	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default)
+	// Paused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 	Paused *AddTorrentsURLsPaused `json:"paused,omitempty"`
 
 	// RatioLimit Set torrent share ratio limit
@@ -514,8 +548,11 @@ type AddTorrentsURLs struct {
 	// SkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 	SkipChecking *AddTorrentsURLsSkipChecking `json:"skip_checking,omitempty"`
 
+	// Stopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+	Stopped *AddTorrentsURLsStopped `json:"stopped,omitempty"`
+
 	// Tags Tags for the torrent, split by ','
-	Tags *[]string `json:"tags,omitempty"`
+	Tags *string `json:"tags,omitempty"`
 
 	// UpLimit Set torrent upload speed limit. Unit in bytes/second
 	UpLimit *int64 `json:"upLimit,omitempty"`
@@ -527,7 +564,7 @@ type AddTorrentsURLs struct {
 // AddTorrentsURLsFirstLastPiecePrio Prioritize download first last piece. Possible values are `true`, `false` (default)
 type AddTorrentsURLsFirstLastPiecePrio string
 
-// AddTorrentsURLsPaused Add torrents in the paused state. Possible values are `true`, `false` (default)
+// AddTorrentsURLsPaused Add torrents in the paused state. Possible values are `true`, `false` (default). Replaced by `stopped` in 2.11.0
 type AddTorrentsURLsPaused string
 
 // AddTorrentsURLsRootFolder Create the root folder. Possible values are `true`, `false`, unset (default)
@@ -539,6 +576,9 @@ type AddTorrentsURLsSequentialDownload string
 // AddTorrentsURLsSkipChecking Skip hash checking. Possible values are `true`, `false` (default)
 type AddTorrentsURLsSkipChecking string
 
+// AddTorrentsURLsStopped Add torrents in the stopped state, since 2.11.0. Possible values are `true`, `false` (default)
+type AddTorrentsURLsStopped string
+
 // BuildInfo The response is a JSON object containing the following fields
 type BuildInfo struct {
 	Bitness    *int32  `json:"bitness,omitempty"`
@@ -556,7 +596,8 @@ type Category struct {
 
 // Hashes defines model for Hashes.
 type Hashes struct {
-	Hashes []string `json:"hashes"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `json:"hashes"`
 }
 
 // MainData The response is a JSON object with the following possible fields
@@ -1306,13 +1347,13 @@ type RssRuleDef struct {
 // SearchJob The response is a JSON object with the following fields
 type SearchJob struct {
 	// Id ID of the search job
-	Id *float32 `json:"id,omitempty"`
+	Id *int `json:"id,omitempty"`
 }
 
 // SearchJobStatus defines model for SearchJobStatus.
 type SearchJobStatus struct {
 	// Id ID of the search job
-	Id *float32 `json:"id,omitempty"`
+	Id *int `json:"id,omitempty"`
 
 	// Status Current status of the search job (either `Running` or `Stopped`)
 	Status *SearchJobStatusStatus `json:"status,omitempty"`
@@ -1996,13 +2037,15 @@ type SetPreferencesUtpTcpMixedMode int32
 
 // SetTorrentsLimit defines model for SetTorrentsLimit.
 type SetTorrentsLimit struct {
-	Hashes []string `json:"hashes"`
-	Limit  *int64   `json:"limit,omitempty"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `json:"hashes"`
+	Limit  *int64 `json:"limit,omitempty"`
 }
 
 // SetTorrentsValue defines model for SetTorrentsValue.
 type SetTorrentsValue struct {
-	Hashes []string `json:"hashes"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `json:"hashes"`
 
 	// Value `value` is a boolean, affects the torrents listed in `hashes`, default is `false`
 	Value *bool `json:"value,omitempty"`
@@ -2025,6 +2068,9 @@ type TorrentInfo struct {
 	// Category Category of the torrent
 	Category *string `json:"category,omitempty"`
 
+	// Comment Torrent comment
+	Comment *string `json:"comment,omitempty"`
+
 	// Completed Amount of transfer data completed (bytes)
 	Completed *int64 `json:"completed,omitempty"`
 
@@ -2040,6 +2086,9 @@ type TorrentInfo struct {
 	// Dlspeed Torrent download speed (bytes/s)
 	Dlspeed *int64 `json:"dlspeed,omitempty"`
 
+	// DownloadPath Path where incomplete data is stored, empty when it is not used
+	DownloadPath *string `json:"download_path,omitempty"`
+
 	// Downloaded Amount of data downloaded
 	Downloaded *int64 `json:"downloaded,omitempty"`
 
@@ -2055,15 +2104,30 @@ type TorrentInfo struct {
 	// ForceStart True if force start is enabled for this torrent
 	ForceStart *bool `json:"force_start,omitempty"`
 
+	// HasMetadata True when metadata of the torrent is downloaded
+	HasMetadata *bool `json:"has_metadata,omitempty"`
+
 	// Hash Torrent hash
 	Hash *string `json:"hash,omitempty"`
 
+	// InactiveSeedingTimeLimit Max inactive seeding time (minutes) of the torrent. `-2` means the global limit is used, `-1` means no limit
+	InactiveSeedingTimeLimit *int64 `json:"inactive_seeding_time_limit,omitempty"`
+
+	// InfohashV1 Info hash v1, empty for v2 only torrents
+	InfohashV1 *string `json:"infohash_v1,omitempty"`
+
+	// InfohashV2 Info hash v2 truncated to 40 characters, empty for v1 only torrents
+	InfohashV2 *string `json:"infohash_v2,omitempty"`
+
 	// LastActivity Last time (Unix Epoch) when a chunk was downloaded/uploaded
 	LastActivity *int64 `json:"last_activity,omitempty"`
 
 	// MagnetUri Magnet URI corresponding to this torrent
 	MagnetUri *string `json:"magnet_uri,omitempty"`
 
+	// MaxInactiveSeedingTime Maximum inactive seeding time (minutes) until torrent is stopped from seeding
+	MaxInactiveSeedingTime *int64 `json:"max_inactive_seeding_time,omitempty"`
+
 	// MaxRatio Maximum share ratio until torrent is stopped from seeding/uploading
 	MaxRatio *float32 `json:"max_ratio,omitempty"`
 
@@ -2085,9 +2149,15 @@ type TorrentInfo struct {
 	// NumSeeds Number of seeds connected to
 	NumSeeds *int64 `json:"num_seeds,omitempty"`
 
+	// Popularity Ratio of uploaded data to the active time, per month
+	Popularity *float32 `json:"popularity,omitempty"`
+
 	// Priority Torrent priority. Returns -1 if queuing is disabled or torrent is in seed mode
 	Priority *int64 `json:"priority,omitempty"`
 
+	// Private True if the torrent is from a private tracker
+	Private *bool `json:"private,omitempty"`
+
 	// Progress Torrent progress (percentage/100)
 	Progress *float32 `json:"progress,omitempty"`
 
@@ -2123,6 +2193,7 @@ type TorrentInfo struct {
 	// | missingFiles       | Torrent data files is missing                                 |
 	// | uploading          | Torrent is being seeded and data is being transferred         |
 	// | pausedUP           | Torrent is paused and has finished downloading                |
+	// | stoppedUP          | Same as pausedUP, since 2.11.0                                |
 	// | queuedUP           | Queuing is enabled and torrent is queued for upload           |
 	// | stalledUP          | Torrent is being seeded, but no connection were made          |
 	// | checkingUP         | Torrent has finished downloading and is being checked         |
@@ -2131,6 +2202,7 @@ type TorrentInfo struct {
 	// | downloading        | Torrent is being downloaded and data is being transferred     |
 	// | metaDL             | Torrent has just started downloading and is fetching metadata |
 	// | pausedDL           | Torrent is paused and has NOT finished downloading            |
+	// | stoppedDL          | Same as pausedDL, since 2.11.0                                |
 	// | queuedDL           | Queuing is enabled and torrent is queued for download         |
 	// | stalledDL          | Torrent is being downloaded, but no connection were made      |
 	// | checkingDL         | Same as checkingUP, but torrent has NOT finished downloading  |
@@ -2176,6 +2248,7 @@ type TorrentInfo struct {
 // | missingFiles       | Torrent data files is missing                                 |
 // | uploading          | Torrent is being seeded and data is being transferred         |
 // | pausedUP           | Torrent is paused and has finished downloading                |
+// | stoppedUP          | Same as pausedUP, since 2.11.0                                |
 // | queuedUP           | Queuing is enabled and torrent is queued for upload           |
 // | stalledUP          | Torrent is being seeded, but no connection were made          |
 // | checkingUP         | Torrent has finished downloading and is being checked         |
@@ -2184,6 +2257,7 @@ type TorrentInfo struct {
 // | downloading        | Torrent is being downloaded and data is being transferred     |
 // | metaDL             | Torrent has just started downloading and is fetching metadata |
 // | pausedDL           | Torrent is paused and has NOT finished downloading            |
+// | stoppedDL          | Same as pausedDL, since 2.11.0                                |
 // | queuedDL           | Queuing is enabled and torrent is queued for download         |
 // | stalledDL          | Torrent is being downloaded, but no connection were made      |
 // | checkingDL         | Same as checkingUP, but torrent has NOT finished downloading  |
@@ -2357,8 +2431,11 @@ type TorrentsProperties struct {
 
 // TorrentsTags defines model for TorrentsTags.
 type TorrentsTags struct {
-	Hashes []string  `json:"hashes"`
-	Tags   *[]string `json:"tags,omitempty"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `json:"hashes"`
+
+	// Tags Tags separated by `,`
+	Tags *string `json:"tags,omitempty"`
 }
 
 // TorrentsTrackers defines model for TorrentsTrackers.
@@ -2574,25 +2651,8 @@ type RssRenameRulePostFormdataBody struct {
 
 // RssSetRulePostFormdataBody defines parameters for RssSetRulePost.
 type RssSetRulePostFormdataBody struct {
-	// RuleDef JSON encoded rule definition
-	//
-	// Rule definition is JSON encoded dictionary with the following fields:
-	// | Field                     | Type   | Description                                             |
-	// | ------------------------- | ------ | ------------------------------------------------------- |
-	// | enabled                   | bool   | Whether the rule is enabled                             |
-	// | mustContain               | string | The substring that the torrent name must contain        |
-	// | mustNotContain            | string | The substring that the torrent name must not contain    |
-	// | useRegex                  | bool   | Enable regex mode in "mustContain" and "mustNotContain" |
-	// | episodeFilter             | string | Episode filter definition                               |
-	// | smartFilter               | bool   | Enable smart episode filter                             |
-	// | previouslyMatchedEpisodes | list   | The list of episode IDs already matched by smart filter |
-	// | affectedFeeds             | list   | The feed URLs the rule applied to                       |
-	// | ignoreDays                | number | Ignore sunsequent rule matches                          |
-	// | lastMatch                 | string | The rule last match time                                |
-	// | addPaused                 | bool   | Add matched torrent in paused mode                      |
-	// | assignedCategory          | string | Assign category to the torrent                          |
-	// | savePath                  | string | Save torrent to the given directory                     |
-	RuleDef RssRuleDef `form:"ruleDef" json:"ruleDef"`
+	// RuleDef RssRuleDef encoded as JSON
+	RuleDef string `form:"ruleDef" json:"ruleDef"`
 
 	// RuleName Rule name (e.g. "Punisher")
 	RuleName string `form:"ruleName" json:"ruleName"`
@@ -2601,7 +2661,7 @@ type RssSetRulePostFormdataBody struct {
 // SearchDeletePostFormdataBody defines parameters for SearchDeletePost.
 type SearchDeletePostFormdataBody struct {
 	// Id ID of the search job
-	Id float32 `form:"id" json:"id"`
+	Id int `form:"id" json:"id"`
 }
 
 // SearchEnablePluginPostFormdataBody defines parameters for SearchEnablePluginPost.
@@ -2610,53 +2670,55 @@ type SearchEnablePluginPostFormdataBody struct {
 	Enable bool `form:"enable" json:"enable"`
 
 	// Names Name of the plugin to enable/disable (e.g. "legittorrents"). Supports multiple names separated by `|`
-	Names []string `form:"names" json:"names"`
+	Names string `form:"names" json:"names"`
 }
 
 // SearchInstallPluginPostFormdataBody defines parameters for SearchInstallPluginPost.
 type SearchInstallPluginPostFormdataBody struct {
 	// Sources Url or file path of the plugin to install (e.g. "https://raw.githubusercontent.com/qbittorrent/search-plugins/master/nova3/engines/legittorrents.py"). Supports multiple sources separated by `|`
-	Sources []string `form:"sources" json:"sources"`
+	Sources string `form:"sources" json:"sources"`
 }
 
 // SearchResultsPostFormdataBody defines parameters for SearchResultsPost.
 type SearchResultsPostFormdataBody struct {
 	// Id ID of the search job
-	Id *float32 `form:"id,omitempty" json:"id,omitempty"`
+	Id *int `form:"id,omitempty" json:"id,omitempty"`
 
 	// Limit max number of results to return. 0 or negative means no limit
-	Limit *float32 `form:"limit,omitempty" json:"limit,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
 	// Offset result to start at. A negative number means count backwards (e.g. -2 returns the 2 most recent results)
-	Offset *float32 `form:"offset,omitempty" json:"offset,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
 // SearchStartPostFormdataBody defines parameters for SearchStartPost.
 type SearchStartPostFormdataBody struct {
 	// Category Categories to limit your search to (e.g. "legittorrents"). Available categories depend on the specified `plugins`. Also supports `all`
-	Category []string `form:"category" json:"category"`
+	Category string `form:"category" json:"category"`
 
 	// Pattern Pattern to search for (e.g. "Ubuntu 18.04")
 	Pattern string `form:"pattern" json:"pattern"`
 
 	// Plugins Plugins to use for searching (e.g. "legittorrents"). Supports multiple plugins separated by `|`. Also supports `all` and `enabled`
-	Plugins []string `form:"plugins" json:"plugins"`
+	Plugins string `form:"plugins" json:"plugins"`
 }
 
 // SearchStatusPostFormdataBody defines parameters for SearchStatusPost.
 type SearchStatusPostFormdataBody struct {
 	// Id ID of the search job. If not specified, all search jobs are returned
-	Id *float32 `form:"id,omitempty" json:"id,omitempty"`
+	Id *int `form:"id,omitempty" json:"id,omitempty"`
 }
 
 // SearchStopPostFormdataBody defines parameters for SearchStopPost.
-type SearchStopPostFormdataBody = struct {
+type SearchStopPostFormdataBody struct {
+	// Id ID of the search job
+	Id int `form:"id" json:"id"`
 }
 
 // SearchUninstallPluginPostFormdataBody defines parameters for SearchUninstallPluginPost.
 type SearchUninstallPluginPostFormdataBody struct {
 	// Names Name of the plugin to uninstall (e.g. "legittorrents"). Supports multiple names separated by `|`
-	Names []string `form:"names" json:"names"`
+	Names string `form:"names" json:"names"`
 }
 
 // SyncMaindataPostFormdataBody defines parameters for SyncMaindataPost.
@@ -2682,10 +2744,10 @@ type TorrentsAddPostMultipartBody struct {
 // TorrentsAddPeersPostFormdataBody defines parameters for TorrentsAddPeersPost.
 type TorrentsAddPeersPostFormdataBody struct {
 	// Hashes The hash of the torrent, or multiple hashes separated by a pipe `|`
-	Hashes []string `form:"hashes" json:"hashes"`
+	Hashes string `form:"hashes" json:"hashes"`
 
 	// Peers The peer to add, or multiple peers separated by a pipe `|`. Each peer is a colon-separated `host:port`
-	Peers []string `form:"peers" json:"peers"`
+	Peers string `form:"peers" json:"peers"`
 }
 
 // TorrentsAddTrackersPostFormdataBody defines parameters for TorrentsAddTrackersPost.
@@ -2695,30 +2757,42 @@ type TorrentsAddTrackersPostFormdataBody struct {
 }
 
 // TorrentsCreateCategoryPostFormdataBody defines parameters for TorrentsCreateCategoryPost.
-type TorrentsCreateCategoryPostFormdataBody = struct {
+type TorrentsCreateCategoryPostFormdataBody struct {
+	// Category Name of the category
+	Category string `form:"category" json:"category"`
+
+	// SavePath Save path of torrents in the category, the default save path when empty
+	SavePath *string `form:"savePath,omitempty" json:"savePath,omitempty"`
 }
 
 // TorrentsCreateTagsPostFormdataBody defines parameters for TorrentsCreateTagsPost.
 type TorrentsCreateTagsPostFormdataBody struct {
 	// Tags `tags` is a list of tags you want to create. Can contain multiple tags separated by `,`.
-	Tags []string `form:"tags" json:"tags"`
+	Tags string `form:"tags" json:"tags"`
 }
 
 // TorrentsDeletePostFormdataBody defines parameters for TorrentsDeletePost.
 type TorrentsDeletePostFormdataBody struct {
 	// DeleteFiles If set to `true`, the downloaded data will also be deleted, otherwise has no effect.
-	DeleteFiles *bool    `form:"deleteFiles,omitempty" json:"deleteFiles,omitempty"`
-	Hashes      []string `form:"hashes" json:"hashes"`
+	DeleteFiles *bool `form:"deleteFiles,omitempty" json:"deleteFiles,omitempty"`
+
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `form:"hashes" json:"hashes"`
 }
 
 // TorrentsDeleteTagsPostFormdataBody defines parameters for TorrentsDeleteTagsPost.
 type TorrentsDeleteTagsPostFormdataBody struct {
 	// Tags `tags` is a list of tags you want to delete. Can contain multiple tags separated by `,`.
-	Tags []string `form:"tags" json:"tags"`
+	Tags string `form:"tags" json:"tags"`
 }
 
 // TorrentsEditCategoryPostFormdataBody defines parameters for TorrentsEditCategoryPost.
-type TorrentsEditCategoryPostFormdataBody = struct {
+type TorrentsEditCategoryPostFormdataBody struct {
+	// Category Name of the category
+	Category string `form:"category" json:"category"`
+
+	// SavePath Save path of torrents in the category, the default save path when empty
+	SavePath *string `form:"savePath,omitempty" json:"savePath,omitempty"`
 }
 
 // TorrentsEditTrackerPostFormdataBody defines parameters for TorrentsEditTrackerPost.
@@ -2739,7 +2813,7 @@ type TorrentsFilePrioPostFormdataBody struct {
 	Hash string `form:"hash" json:"hash"`
 
 	// Id File ids, separated by `|`
-	Id []int64 `form:"id" json:"id"`
+	Id string `form:"id" json:"id"`
 
 	// Priority File priority to set (consult [torrent contents API](https://github.com/qbittorrent/qBittorrent/wiki/WebUI-API-(qBittorrent-4.1)#get-torrent-contents) for possible values)
 	Priority TorrentsFilePrioPostFormdataBodyPriority `form:"priority" json:"priority"`
@@ -2754,7 +2828,7 @@ type TorrentsFilesPostFormdataBody struct {
 	Hash string `form:"hash" json:"hash"`
 
 	// Indexes The indexes of the files you want to retrieve. `indexes` can contain multiple values separated by `|`.
-	Indexes *[]string `form:"indexes,omitempty" json:"indexes,omitempty"`
+	Indexes *string `form:"indexes,omitempty" json:"indexes,omitempty"`
 }
 
 // TorrentsInfoPostFormdataBody defines parameters for TorrentsInfoPost.
@@ -2762,11 +2836,11 @@ type TorrentsInfoPostFormdataBody struct {
 	// Category Get torrents with the given category (empty string means "without category"; no "category" parameter means "any category" <- broken until [#11748](https://github.com/qbittorrent/qBittorrent/issues/11748) is resolved). Remember to URL-encode the category name. For example, `My category` becomes `My%20category`
 	Category *string `form:"category,omitempty" json:"category,omitempty"`
 
-	// Filter Filter torrent list by state. Allowed state filters: `all`, `downloading`, `seeding`, `completed`, `paused`, `active`, `inactive`, `resumed`, `stalled`, `stalled_uploading`, `stalled_downloading`, `errored`
+	// Filter Filter torrent list by state. Allowed state filters: `all`, `downloading`, `seeding`, `completed`, `paused` (`stopped` since 2.11.0), `active`, `inactive`, `resumed` (`running` since 2.11.0), `stalled`, `stalled_uploading`, `stalled_downloading`, `errored`
 	Filter *TorrentsInfoPostFormdataBodyFilter `form:"filter,omitempty" json:"filter,omitempty"`
 
 	// Hashes Filter by hashes. Can contain multiple hashes separated by `|`
-	Hashes *[]string `form:"hashes,omitempty" json:"hashes,omitempty"`
+	Hashes *string `form:"hashes,omitempty" json:"hashes,omitempty"`
 
 	// Limit Limit the number of torrents returned
 	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
@@ -2817,7 +2891,7 @@ type TorrentsRemoveTrackersPostFormdataBody struct {
 	Hash string `form:"hash" json:"hash"`
 
 	// Urls URLs to remove, separated by `|`
-	Urls []string `form:"urls" json:"urls"`
+	Urls string `form:"urls" json:"urls"`
 }
 
 // TorrentsRenamePostFormdataBody defines parameters for TorrentsRenamePost.
@@ -2829,20 +2903,25 @@ type TorrentsRenamePostFormdataBody struct {
 // TorrentsSetAutoManagementPostFormdataBody defines parameters for TorrentsSetAutoManagementPost.
 type TorrentsSetAutoManagementPostFormdataBody struct {
 	// Enable `enable` is a boolean, affects the torrents listed in `hashes`, default is `false`
-	Enable *bool    `form:"enable,omitempty" json:"enable,omitempty"`
-	Hashes []string `form:"hashes" json:"hashes"`
+	Enable *bool `form:"enable,omitempty" json:"enable,omitempty"`
+
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `form:"hashes" json:"hashes"`
 }
 
 // TorrentsSetCategoryPostFormdataBody defines parameters for TorrentsSetCategoryPost.
 type TorrentsSetCategoryPostFormdataBody struct {
 	// Category `category` is the torrent category you want to set.
-	Category *string  `form:"category,omitempty" json:"category,omitempty"`
-	Hashes   []string `form:"hashes" json:"hashes"`
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `form:"hashes" json:"hashes"`
 }
 
 // TorrentsSetLocationPostFormdataBody defines parameters for TorrentsSetLocationPost.
 type TorrentsSetLocationPostFormdataBody struct {
-	Hashes []string `form:"hashes" json:"hashes"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `form:"hashes" json:"hashes"`
 
 	// Location `location` is the location to download the torrent to. If the location doesn't exist, the torrent's location is unchanged.
 	Location *string `form:"location,omitempty" json:"location,omitempty"`
@@ -2850,13 +2929,17 @@ type TorrentsSetLocationPostFormdataBody struct {
 
 // TorrentsSetShareLimitsPostFormdataBody defines parameters for TorrentsSetShareLimitsPost.
 type TorrentsSetShareLimitsPostFormdataBody struct {
-	Hashes []string `form:"hashes" json:"hashes"`
+	// Hashes Hashes separated by `|` or `all` for all torrents
+	Hashes string `form:"hashes" json:"hashes"`
+
+	// InactiveSeedingTimeLimit `inactiveSeedingTimeLimit` is the max amount of time the torrent should be seeded without activity. `-2` means the global limit should be used, `-1` means no limit. Required since 2.9.2.
+	InactiveSeedingTimeLimit *int `form:"inactiveSeedingTimeLimit,omitempty" json:"inactiveSeedingTimeLimit,omitempty"`
 
 	// RatioLimit `ratioLimit` is the max ratio the torrent should be seeded until. `-2` means the global limit should be used, -1 means no limit.
 	RatioLimit *float32 `form:"ratioLimit,omitempty" json:"ratioLimit,omitempty"`
 
 	// SeedingTimeLimit `seedingTimeLimit` is the max amount of time the torrent should be seeded. `-2` means the global limit should be used, `-1` means no limit.
-	SeedingTimeLimit *float32 `form:"seedingTimeLimit,omitempty" json:"seedingTimeLimit,omitempty"`
+	SeedingTimeLimit *int `form:"seedingTimeLimit,omitempty" json:"seedingTimeLimit,omitempty"`
 }
 
 // TorrentsTrackersPostFormdataBody defines parameters for TorrentsTrackersPost.
@@ -2874,7 +2957,7 @@ type TorrentWebseedsPostFormdataBody struct {
 // TransferBanPeersPostFormdataBody defines parameters for TransferBanPeersPost.
 type TransferBanPeersPostFormdataBody struct {
 	// Peers The peer to ban, or multiple peers separated by a pipe `|`. Each peer is a colon-separated `host:port`
-	Peers *[]string `form:"peers,omitempty" json:"peers,omitempty"`
+	Peers *string `form:"peers,omitempty" json:"peers,omitempty"`
 }
 
 // TransferSetDownloadLimitPostFormdataBody defines parameters for TransferSetDownloadLimitPost.
@@ -2953,7 +3036,7 @@ type SearchStartPostFormdataRequestBody SearchStartPostFormdataBody
 type SearchStatusPostFormdataRequestBody SearchStatusPostFormdataBody
 
 // SearchStopPostFormdataRequestBody defines body for SearchStopPost for application/x-www-form-urlencoded ContentType.
-type SearchStopPostFormdataRequestBody = SearchStopPostFormdataBody
+type SearchStopPostFormdataRequestBody SearchStopPostFormdataBody
 
 // SearchUninstallPluginPostFormdataRequestBody defines body for SearchUninstallPluginPost for application/x-www-form-urlencoded ContentType.
 type SearchUninstallPluginPostFormdataRequestBody SearchUninstallPluginPostFormdataBody
@@ -2980,7 +3063,7 @@ type TorrentsAddTrackersPostFormdataRequestBody TorrentsAddTrackersPostFormdataB
 type TorrentsBottomPrioPostFormdataRequestBody = Hashes
 
 // TorrentsCreateCategoryPostFormdataRequestBody defines body for TorrentsCreateCategoryPost for application/x-www-form-urlencoded ContentType.
-type TorrentsCreateCategoryPostFormdataRequestBody = TorrentsCreateCategoryPostFormdataBody
+type TorrentsCreateCategoryPostFormdataRequestBody TorrentsCreateCategoryPostFormdataBody
 
 // TorrentsCreateTagsPostFormdataRequestBody defines body for TorrentsCreateTagsPost for application/x-www-form-urlencoded ContentType.
 type TorrentsCreateTagsPostFormdataRequestBody TorrentsCreateTagsPostFormdataBody
@@ -2998,7 +3081,7 @@ type TorrentsDeleteTagsPostFormdataRequestBody TorrentsDeleteTagsPostFormdataBod
 type TorrentsDownloadLimitPostFormdataRequestBody = Hashes
 
 // TorrentsEditCategoryPostFormdataRequestBody defines body for TorrentsEditCategoryPost for application/x-www-form-urlencoded ContentType.
-type TorrentsEditCategoryPostFormdataRequestBody = TorrentsEditCategoryPostFormdataBody
+type TorrentsEditCategoryPostFormdataRequestBody TorrentsEditCategoryPostFormdataBody
 
 // TorrentsEditTrackerPostFormdataRequestBody defines body for TorrentsEditTrackerPost for application/x-www-form-urlencoded ContentType.
 type TorrentsEditTrackerPostFormdataRequestBody TorrentsEditTrackerPostFormdataBody
@@ -3078,6 +3161,12 @@ type TorrentsSetSuperSeedingPostFormdataRequestBody = SetTorrentsValue
 // TorrentsSetUploadLimitPostFormdataRequestBody defines body for TorrentsSetUploadLimitPost for application/x-www-form-urlencoded ContentType.
 type TorrentsSetUploadLimitPostFormdataRequestBody = SetTorrentsLimit
 
+// TorrentsStartPostFormdataRequestBody defines body for TorrentsStartPost for application/x-www-form-urlencoded ContentType.
+type TorrentsStartPostFormdataRequestBody = Hashes
+
+// TorrentsStopPostFormdataRequestBody defines body for TorrentsStopPost for application/x-www-form-urlencoded ContentType.
+type TorrentsStopPostFormdataRequestBody = Hashes
+
 // TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody defines body for TorrentsToggleFirstLastPiecePrioPost for application/x-www-form-urlencoded ContentType.
 type TorrentsToggleFirstLastPiecePrioPostFormdataRequestBody = Hashes
 
@@ -1,412 +1,501 @@
 package qbitorrent
 
 import (
-	"bytes"
-	"encoding/json"
-	"errors"
+	"context"
 	"fmt"
-	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
-	"net/url"
-	"reflect"
+	"strconv"
 	"strings"
-)
+	"sync"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 
-const baseApi = "/api/v2"
+	"github.com/shadream/kftm/qbitorrent/api"
+)
 
-var ErrBadResponse = errors.New("bad response")
+const (
+	baseApi       = "/api/v2"
+	loginEndpoint = "auth/login"
+)
 
+// Client is a facade over the generated client with methods kftm needs. It
+// keeps the session cookie and logs in again when the session expires.
+// Endpoints without a facade method are available through API.
 type Client struct {
 	BaseUrl string
 	client  *http.Client
+	api     *api.ClientWithResponses
+
+	// credentials of the last successful login, used to login again when
+	// the session expires
+	mu       sync.Mutex
+	username string
+	password string
+	// WebAPI version of the session, endpoints and states differ between
+	// versions
+	version *APIVersion
 }
 
 func NewClient(BaseUrl string) *Client {
-	client := http.Client{}
-	return &Client{
+	jar, _ := cookiejar.New(&cookiejar.Options{})
+	c := &Client{
 		BaseUrl: BaseUrl,
-		client:  &client,
+		client:  &http.Client{Jar: jar},
 	}
+
+	// options never fail, errors of the server url are returned by requests
+	c.api, _ = api.NewClientWithResponses(strings.TrimSuffix(BaseUrl, "/")+baseApi,
+		api.WithHTTPClient(doerFunc(c.do)),
+		api.WithRequestEditorFn(setHeaders),
+	)
+
+	return c
 }
 
-func (c *Client) post(endpoint string, opts map[string]string) (*http.Response, error) {
-	endpoint, err := url.JoinPath(c.BaseUrl, baseApi, endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("join endpoint path: %w", err)
-	}
+// API returns the generated client. Its requests share the session of c.
+func (c *Client) API() *api.ClientWithResponses {
+	return c.api
+}
 
-	// add optional parameters that the user wants
-	form := url.Values{}
-	if opts != nil {
-		for k, v := range opts {
-			form.Add(k, v)
-		}
-	}
+// SetTimeout limits time of every request made by the client. Zero means no
+// timeout.
+func (c *Client) SetTimeout(timeout time.Duration) {
+	c.client.Timeout = timeout
+}
 
-	req, err := http.NewRequest(http.MethodPost, endpoint,
-		strings.NewReader(form.Encode()))
-	if err != nil {
-		return nil, fmt.Errorf("create post request: %w", err)
-	}
+// doerFunc lets a function be used as HttpRequestDoer of the generated
+// client.
+type doerFunc func(req *http.Request) (*http.Response, error)
 
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// setHeaders adds headers qbitorrent checks to protect from CSRF.
+func setHeaders(ctx context.Context, req *http.Request) error {
 	host := fmt.Sprintf("%s://%s", req.URL.Scheme, req.Host)
 
 	req.Header.Set("Referer", host)
 	req.Header.Set("Origin", host)
 
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do post request: %w", err)
-	}
+	// add user-agent header to allow qbittorrent to identify us
+	req.Header.Set("User-Agent", "kftm v0.1")
 
-	return resp, nil
+	return nil
 }
 
-func (c *Client) postMultipart(endpoint string, opts map[string]string) (*http.Response, error) {
-	endpoint, err := url.JoinPath(c.BaseUrl, baseApi, endpoint)
+// do sends the request. Responses with status other than 200 are turned into
+// ResponseError. When qbitorrent forgets the session (timeout or restart) it
+// answers 403, then the client logs in again and repeats the request once.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	endpoint := endpointOf(req)
+
+	resp, err := c.client.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("join endpoint path: %w", err)
+		return nil, fmt.Errorf("do %s request: %w", endpoint, err)
 	}
 
-	body := new(bytes.Buffer)
-	args := multipart.NewWriter(body)
+	if resp.StatusCode == http.StatusForbidden && endpoint != loginEndpoint && c.hasCredentials() {
+		closeBody(resp.Body)
 
-	for key, value := range opts {
-		args.WriteField(key, value)
+		resp, err = c.retryAfterLogin(req, endpoint)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	args.Close()
-
-	req, err := http.NewRequest(http.MethodPost, endpoint, body)
-	if err != nil {
-		return nil, fmt.Errorf("create post request: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		return nil, newResponseError(resp, endpoint)
 	}
 
-	host := fmt.Sprintf("%s://%s", req.URL.Scheme, req.Host)
-
-	req.Header.Set("Referer", host)
-	req.Header.Set("Origin", host)
+	return resp, nil
+}
 
-	req.Header.Set("Content-Type", args.FormDataContentType())
+func (c *Client) retryAfterLogin(req *http.Request, endpoint string) (*http.Response, error) {
+	c.mu.Lock()
+	username, password := c.username, c.password
+	c.mu.Unlock()
 
-	resp, err := c.client.Do(req)
+	err := c.LoginContext(req.Context(), username, password)
 	if err != nil {
-		return nil, fmt.Errorf("do post request: %w", err)
+		return nil, fmt.Errorf("login again after session expired: %w", err)
 	}
 
-	return resp, nil
-}
-
-func (c *Client) get(endpoint string, opts map[string]string) (*http.Response, error) {
-	endpoint, err := url.JoinPath(c.BaseUrl, baseApi, endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("join endpoint path: %w", err)
+	// http client puts cookies of the jar into the request headers, drop the
+	// expired session cookie so the new one is sent
+	retry := req.Clone(req.Context())
+	retry.Header.Del("Cookie")
+	if req.GetBody != nil {
+		retry.Body, err = req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("copy %s request body: %w", endpoint, err)
+		}
 	}
 
-	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	resp, err := c.client.Do(retry)
 	if err != nil {
-		return nil, fmt.Errorf("create get request: %w", err)
+		return nil, fmt.Errorf("do %s request: %w", endpoint, err)
 	}
 
-	host := fmt.Sprintf("%s://%s", req.URL.Scheme, req.Host)
+	return resp, nil
+}
 
-	req.Header.Set("Referer", host)
-	req.Header.Set("Origin", host)
+func (c *Client) hasCredentials() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 
-	// add user-agent header to allow qbittorrent to identify us
-	req.Header.Set("User-Agent", "kftm v0.1")
+	return c.username != ""
+}
 
-	// add optional parameters that the user wants
-	if opts != nil {
-		query := req.URL.Query()
-		for k, v := range opts {
-			query.Add(k, v)
-		}
-		req.URL.RawQuery = query.Encode()
+// endpointOf returns path of the request relative to the api root, like
+// "torrents/info".
+func endpointOf(req *http.Request) string {
+	_, endpoint, found := strings.Cut(req.URL.Path, baseApi+"/")
+	if !found {
+		return req.URL.Path
 	}
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("do get request: %w", err)
-	}
-
-	return resp, nil
+	return endpoint
 }
 
+// Login starts a session. The credentials are kept to renew the session
+// when it expires. Login is not needed when qbitorrent bypasses
+// authentication for clients on localhost or whitelisted subnets.
 func (c *Client) Login(username, password string) error {
-	credentials := make(map[string]string)
-	credentials["username"] = username
-	credentials["password"] = password
+	return c.LoginContext(context.Background(), username, password)
+}
 
-	resp, err := c.post("auth/login", credentials)
+func (c *Client) LoginContext(ctx context.Context, username, password string) error {
+	resp, err := c.api.AuthLoginPostWithFormdataBodyWithResponse(ctx, nil, api.AuthLoginPostFormdataRequestBody{
+		Username: username,
+		Password: password,
+	})
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("login response: %w", ErrBadResponse)
-	}
-
-	cookies := resp.Cookies()
+	// wrong credentials are reported with status 200 and "Fails." in body
+	text := strings.TrimSpace(string(resp.Body))
+	if text != "Ok." {
+		if len(text) > bodySnippetSize {
+			text = text[:bodySnippetSize]
+		}
 
-	if len(cookies) > 0 {
-		if c.client.Jar == nil {
-			jar, _ := cookiejar.New(&cookiejar.Options{})
-			c.client.Jar = jar
+		return &ResponseError{
+			StatusCode: resp.StatusCode(),
+			Endpoint:   loginEndpoint,
+			Body:       text,
+			Err:        ErrUnauthorized,
 		}
-		c.client.Jar.SetCookies(resp.Request.URL, cookies)
 	}
 
-	return nil
+	// SID cookie is saved to the jar by http client
+	c.mu.Lock()
+	c.username = username
+	c.password = password
+	// qbitorrent may have been upgraded while the session was expired
+	c.version = nil
+	c.mu.Unlock()
+
+	_, err = c.APIVersionContext(ctx)
+
+	return err
 }
 
+// Logout ends the session and forgets credentials, so the client will not
+// login again by itself.
+func (c *Client) Logout() error {
+	return c.LogoutContext(context.Background())
+}
+
+func (c *Client) LogoutContext(ctx context.Context) error {
+	c.mu.Lock()
+	c.username = ""
+	c.password = ""
+	c.mu.Unlock()
+
+	_, err := c.api.AuthLogoutPostWithResponse(ctx)
+
+	return err
+}
+
+// GetTorrentList returns torrents matching filters. Filters and states of
+// older versions are converted, paused torrents have stoppedDL and stoppedUP
+// states with any version.
 func (c *Client) GetTorrentList(filters TorrentsInfoPostFormdataBody) ([]TorrentInfo, error) {
-	var t []TorrentInfo
+	return c.GetTorrentListContext(context.Background(), filters)
+}
 
-	args, err := StructToMap(filters)
-	if err != nil {
-		return nil, fmt.Errorf("convert struct to map: %w", err)
+func (c *Client) GetTorrentListContext(ctx context.Context, filters TorrentsInfoPostFormdataBody) ([]TorrentInfo, error) {
+	if filters.Filter != nil {
+		stopStart, err := c.stopStart(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		filter := versionFilter(*filters.Filter, stopStart)
+		filters.Filter = &filter
 	}
 
-	resp, err := c.post("torrents/info", args)
+	resp, err := c.api.TorrentsInfoPostWithFormdataBodyWithResponse(ctx, api.TorrentsInfoPostFormdataRequestBody(filters))
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, wrapWrongStatusCode(resp.StatusCode)
+	if resp.JSON200 == nil {
+		return nil, nil
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&t)
-	if err != nil {
-		return nil, fmt.Errorf("decode request body: %w", err)
+	torrents := *resp.JSON200
+	for i := range torrents {
+		normalizeState(torrents[i].State)
 	}
 
-	return t, nil
+	return torrents, nil
 }
 
-func (c *Client) GetTorrentContent(hash string) ([]TorrentsFiles, error) {
-	var t []TorrentsFiles
-
-	args := map[string]string{
-		"hash": hash,
-	}
+// GetTorrent returns torrent with the hash or ErrNotFound.
+func (c *Client) GetTorrent(hash string) (*TorrentInfo, error) {
+	return c.GetTorrentContext(context.Background(), hash)
+}
 
-	resp, err := c.post("/torrents/files", args)
+func (c *Client) GetTorrentContext(ctx context.Context, hash string) (*TorrentInfo, error) {
+	t, err := c.GetTorrentListContext(ctx, TorrentsInfoPostFormdataBody{
+		Hashes: &hash,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, wrapWrongStatusCode(resp.StatusCode)
+	if len(t) == 0 {
+		return nil, fmt.Errorf("torrent %s: %w", hash, ErrNotFound)
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(&t)
+	return &t[0], nil
+}
+
+func (c *Client) GetTorrentContent(hash string) ([]TorrentsFiles, error) {
+	return c.GetTorrentContentContext(context.Background(), hash)
+}
+
+func (c *Client) GetTorrentContentContext(ctx context.Context, hash string) ([]TorrentsFiles, error) {
+	resp, err := c.api.TorrentsFilesPostWithFormdataBodyWithResponse(ctx, api.TorrentsFilesPostFormdataRequestBody{Hash: hash})
 	if err != nil {
-		return nil, fmt.Errorf("decode request body: %w", err)
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
 	}
 
-	return t, nil
+	return *resp.JSON200, nil
 }
 
 func (c *Client) CreateTorrentFileUrl(addTorrentInfo AddTorrentsURLs) error {
-	args, err := StructToMap(addTorrentInfo)
+	return c.CreateTorrentFileUrlContext(context.Background(), addTorrentInfo)
+}
+
+func (c *Client) CreateTorrentFileUrlContext(ctx context.Context, addTorrentInfo AddTorrentsURLs) error {
+	stopStart, err := c.stopStart(ctx)
 	if err != nil {
-		return fmt.Errorf("convert struct to map: %w", err)
+		return err
+	}
+
+	if stopStart && addTorrentInfo.Paused != nil && addTorrentInfo.Stopped == nil {
+		stopped := AddTorrentsURLsStopped(*addTorrentInfo.Paused)
+		addTorrentInfo.Stopped = &stopped
 	}
 
-	resp, err := c.postMultipart("/torrents/add", args)
+	body, contentType, err := encodeMultipart(addTorrentInfo, "", nil)
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return wrapWrongStatusCode(resp.StatusCode)
-	}
+	_, err = c.api.TorrentsAddPostWithBodyWithResponse(ctx, contentType, body)
 
-	return nil
+	return err
 }
 
-func (c *Client) RenameFile(data RenameTorrentFiles) error {
-	args, err := StructToMap(data)
-	if err != nil {
-		return fmt.Errorf("convert struct to map: %w", err)
-	}
+// CreateTorrentFiles uploads .torrent files from addTorrentInfo.Torrents.
+func (c *Client) CreateTorrentFiles(addTorrentInfo AddTorrentsFiles) error {
+	return c.CreateTorrentFilesContext(context.Background(), addTorrentInfo)
+}
 
-	resp, err := c.post("/torrents/renameFile", args)
-	if err != nil {
-		return err
+func (c *Client) CreateTorrentFilesContext(ctx context.Context, addTorrentInfo AddTorrentsFiles) error {
+	var files []openapi_types.File
+	if addTorrentInfo.Torrents != nil {
+		files = *addTorrentInfo.Torrents
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return wrapWrongStatusCode(resp.StatusCode)
+	if len(files) == 0 {
+		return fmt.Errorf("no torrent files to add")
 	}
 
-	return nil
-}
+	// files are sent as separate parts, not as a field
+	addTorrentInfo.Torrents = nil
 
-func (c *Client) RenameFolder(data RenameTorrentFiles) error {
-	args, err := StructToMap(data)
+	stopStart, err := c.stopStart(ctx)
 	if err != nil {
-		return fmt.Errorf("convert struct to map: %w", err)
+		return err
+	}
+
+	if stopStart && addTorrentInfo.Paused != nil && addTorrentInfo.Stopped == nil {
+		stopped := AddTorrentsFilesStopped(*addTorrentInfo.Paused)
+		addTorrentInfo.Stopped = &stopped
 	}
 
-	resp, err := c.post("/torrents/renameFolder", args)
+	body, contentType, err := encodeMultipart(addTorrentInfo, "torrents", files)
 	if err != nil {
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return wrapWrongStatusCode(resp.StatusCode)
-	}
+	_, err = c.api.TorrentsAddPostWithBodyWithResponse(ctx, contentType, body)
 
-	return nil
+	return err
 }
 
-func (c *Client) GetAllCategories() (map[string]Category, error) {
-	var result map[string]Category
+func (c *Client) RenameFile(data RenameTorrentFiles) error {
+	return c.RenameFileContext(context.Background(), data)
+}
 
-	resp, err := c.get("/torrents/categories", nil)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) RenameFileContext(ctx context.Context, data RenameTorrentFiles) error {
+	_, err := c.api.TorrentsRenameFilePostWithFormdataBodyWithResponse(ctx, data)
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, wrapWrongStatusCode(resp.StatusCode)
-	}
+	return err
+}
 
-	err = json.NewDecoder(resp.Body).Decode(&result)
-	if err != nil {
-		return nil, fmt.Errorf("decode request body: %w", err)
-	}
+func (c *Client) RenameFolder(data RenameTorrentFiles) error {
+	return c.RenameFolderContext(context.Background(), data)
+}
 
-	return result, nil
+func (c *Client) RenameFolderContext(ctx context.Context, data RenameTorrentFiles) error {
+	_, err := c.api.TorrentsRenameFolderPostWithFormdataBodyWithResponse(ctx, data)
+
+	return err
 }
 
-func (c *Client) CreateCategory(category, savePath string) error {
-	args := map[string]string{
-		"category": category,
-		"savePath": savePath,
+// DeleteTorrents removes torrents from qbitorrent, with their downloaded
+// data when deleteFiles is set.
+func (c *Client) DeleteTorrents(hashes []string, deleteFiles bool) error {
+	return c.DeleteTorrentsContext(context.Background(), hashes, deleteFiles)
+}
+
+func (c *Client) DeleteTorrentsContext(ctx context.Context, hashes []string, deleteFiles bool) error {
+	_, err := c.api.TorrentsDeletePostWithFormdataBodyWithResponse(ctx, api.TorrentsDeletePostFormdataRequestBody{
+		Hashes:      joinHashes(hashes),
+		DeleteFiles: &deleteFiles,
+	})
+
+	return err
+}
+
+func (c *Client) AddTags(hashes []string, tags []string) error {
+	return c.AddTagsContext(context.Background(), hashes, tags)
+}
+
+func (c *Client) AddTagsContext(ctx context.Context, hashes []string, tags []string) error {
+	joined := joinList(tags)
+	_, err := c.api.TorrentsAddTagsPostWithFormdataBodyWithResponse(ctx, api.TorrentsAddTagsPostFormdataRequestBody{
+		Hashes: joinHashes(hashes),
+		Tags:   &joined,
+	})
+
+	return err
+}
+
+func (c *Client) RemoveTags(hashes []string, tags []string) error {
+	return c.RemoveTagsContext(context.Background(), hashes, tags)
+}
+
+func (c *Client) RemoveTagsContext(ctx context.Context, hashes []string, tags []string) error {
+	joined := joinList(tags)
+	_, err := c.api.TorrentsRemoveTagsPostWithFormdataBodyWithResponse(ctx, api.TorrentsRemoveTagsPostFormdataRequestBody{
+		Hashes: joinHashes(hashes),
+		Tags:   &joined,
+	})
+
+	return err
+}
+
+// SetFilePriority sets priority of files with given indexes, priority 0
+// means the file is not downloaded.
+func (c *Client) SetFilePriority(hash string, indexes []int64, priority TorrentsFilesPriority) error {
+	return c.SetFilePriorityContext(context.Background(), hash, indexes, priority)
+}
+
+func (c *Client) SetFilePriorityContext(ctx context.Context, hash string, indexes []int64, priority TorrentsFilesPriority) error {
+	ids := make([]string, len(indexes))
+	for i, index := range indexes {
+		ids[i] = strconv.FormatInt(index, 10)
 	}
 
-	resp, err := c.post("/torrents/renameFolder", args)
+	_, err := c.api.TorrentsFilePrioPostWithFormdataBodyWithResponse(ctx, api.TorrentsFilePrioPostFormdataRequestBody{
+		Hash:     hash,
+		Id:       strings.Join(ids, "|"),
+		Priority: api.TorrentsFilePrioPostFormdataBodyPriority(priority),
+	})
+
+	return err
+}
+
+func (c *Client) GetAllCategories() (map[string]TorrentsCategory, error) {
+	return c.GetAllCategoriesContext(context.Background())
+}
+
+func (c *Client) GetAllCategoriesContext(ctx context.Context) (map[string]TorrentsCategory, error) {
+	resp, err := c.api.TorrentsCategoriesGetWithResponse(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return wrapWrongStatusCode(resp.StatusCode)
+	if resp.JSON200 == nil {
+		return nil, nil
 	}
 
-	return nil
+	return *resp.JSON200, nil
 }
 
-func StructToMap(obj interface{}) (map[string]string, error) {
-	result := make(map[string]string)
-	v := reflect.ValueOf(obj)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
-	}
-	if v.Kind() != reflect.Struct {
-		return nil, fmt.Errorf("expected a struct, got %s", v.Kind())
-	}
+// CreateCategory adds a category, empty savePath means the default save
+// path. qbitorrent answers ErrConflict when the name is invalid.
+func (c *Client) CreateCategory(category, savePath string) error {
+	return c.CreateCategoryContext(context.Background(), category, savePath)
+}
 
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
+func (c *Client) CreateCategoryContext(ctx context.Context, category, savePath string) error {
+	_, err := c.api.TorrentsCreateCategoryPostWithFormdataBodyWithResponse(ctx, api.TorrentsCreateCategoryPostFormdataRequestBody{
+		Category: category,
+		SavePath: &savePath,
+	})
 
-		// Skip unexported fields
-		if field.PkgPath != "" {
-			continue
-		}
+	return err
+}
 
-		// Get the JSON tag
-		jsonTag := field.Tag.Get("json")
-		if jsonTag == "" {
-			continue
-		}
+// EditCategory changes save path of an existing category.
+func (c *Client) EditCategory(category, savePath string) error {
+	return c.EditCategoryContext(context.Background(), category, savePath)
+}
 
-		// Extract the JSON field name and check for "omitempty"
-		jsonFieldName := strings.Split(jsonTag, ",")[0]
-		omitEmpty := strings.Contains(jsonTag, "omitempty")
-
-		if fieldValue.Kind() == reflect.Ptr {
-			if fieldValue.IsNil() {
-				if omitEmpty {
-					continue // Skip nil pointers if omitempty is set
-				}
-				result[jsonFieldName] = "" // Add empty string for nil pointers
-				continue
-			}
-			fieldValue = fieldValue.Elem() // Dereference the pointer
-		}
+func (c *Client) EditCategoryContext(ctx context.Context, category, savePath string) error {
+	_, err := c.api.TorrentsEditCategoryPostWithFormdataBodyWithResponse(ctx, api.TorrentsEditCategoryPostFormdataRequestBody{
+		Category: category,
+		SavePath: &savePath,
+	})
 
-		// Check if the field is a zero value and has "omitempty"
-		if omitEmpty && isZero(fieldValue) {
-			continue
-		}
+	return err
+}
 
-		// Convert the field value to a string
-		var strValue string
-		switch fieldValue.Kind() {
-		case reflect.String:
-			strValue = fieldValue.String()
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			strValue = fmt.Sprintf("%d", fieldValue.Int())
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			strValue = fmt.Sprintf("%d", fieldValue.Uint())
-		case reflect.Float32, reflect.Float64:
-			strValue = fmt.Sprintf("%f", fieldValue.Float())
-		case reflect.Bool:
-			strValue = fmt.Sprintf("%t", fieldValue.Bool())
-		case reflect.Pointer:
-		default:
-			// For other types, use JSON marshaling
-			jsonBytes, err := json.Marshal(fieldValue.Interface())
-			if err != nil {
-				return nil, fmt.Errorf("failed to marshal field %s: %v", field.Name, err)
-			}
-			strValue = string(jsonBytes)
-		}
+// RemoveCategories deletes categories, their torrents are left without
+// category.
+func (c *Client) RemoveCategories(categories []string) error {
+	return c.RemoveCategoriesContext(context.Background(), categories)
+}
 
-		result[jsonFieldName] = strValue
-	}
+func (c *Client) RemoveCategoriesContext(ctx context.Context, categories []string) error {
+	_, err := c.api.TorrentsRemoveCategoriesPostWithFormdataBodyWithResponse(ctx, api.TorrentsRemoveCategoriesPostFormdataRequestBody{
+		Categories: strings.Join(categories, "\n"),
+	})
 
-	return result, nil
-}
-
-func wrapWrongStatusCode(statusCode int) error {
-	return fmt.Errorf("wrong status code %d: %w", statusCode, ErrBadResponse)
-}
-
-// isZero checks if a reflect.Value is the zero value for its type.
-func isZero(v reflect.Value) bool {
-	switch v.Kind() {
-	case reflect.String:
-		return v.Len() == 0
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return v.Int() == 0
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return v.Uint() == 0
-	case reflect.Float32, reflect.Float64:
-		return v.Float() == 0
-	case reflect.Bool:
-		return !v.Bool()
-	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
-		return v.IsNil()
-	case reflect.Struct:
-		// For structs, check if all fields are zero
-		for i := 0; i < v.NumField(); i++ {
-			if !isZero(v.Field(i)) {
-				return false
-			}
-		}
-		return true
-	default:
-		return false
-	}
+	return err
 }
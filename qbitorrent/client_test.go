@@ -0,0 +1,682 @@
+package qbitorrent_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/shadream/kftm/qbitorrent"
+	"github.com/shadream/kftm/qbitorrent/api"
+	"github.com/shadream/kftm/qbitorrent/qbittest"
+)
+
+const magnetHash = "0123456789abcdef0123456789abcdef01234567"
+
+func newServer(t *testing.T) *qbittest.Server {
+	t.Helper()
+
+	srv := qbittest.NewServer()
+	srv.Username = "admin"
+	srv.Password = "secret"
+	srv.DefaultSavePath = "/downloads"
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newClient(t *testing.T, srv *qbittest.Server) *qbitorrent.Client {
+	t.Helper()
+
+	client := qbitorrent.NewClient(srv.URL)
+	err := client.Login(srv.Username, srv.Password)
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	return client
+}
+
+func addFile(t *testing.T, client *qbitorrent.Client, data []byte, info qbitorrent.AddTorrentsFiles) error {
+	t.Helper()
+
+	var file openapi_types.File
+	file.InitFromBytes(data, "test.torrent")
+	info.Torrents = &[]openapi_types.File{file}
+
+	return client.CreateTorrentFiles(info)
+}
+
+func movieTorrent() []byte {
+	return qbittest.TorrentFile("Movie.2010.1080p",
+		qbittest.File{Name: "Movie.2010.1080p/movie.mkv", Size: 4 << 30},
+		qbittest.File{Name: "Movie.2010.1080p/Subs/rus.srt", Size: 1 << 10},
+	)
+}
+
+func torrentHash(t *testing.T, client *qbitorrent.Client) string {
+	t.Helper()
+
+	torrents, err := client.GetTorrentList(qbitorrent.TorrentsInfoPostFormdataBody{})
+	if err != nil {
+		t.Fatalf("get torrents: %v", err)
+	}
+	if len(torrents) != 1 {
+		t.Fatalf("got %d torrents, want 1", len(torrents))
+	}
+
+	return *torrents[0].Hash
+}
+
+func TestLogin(t *testing.T) {
+	srv := newServer(t)
+	client := qbitorrent.NewClient(srv.URL)
+
+	_, err := client.GetTags()
+	if !errors.Is(err, qbitorrent.ErrUnauthorized) {
+		t.Fatalf("request without session: got %v, want ErrUnauthorized", err)
+	}
+
+	err = client.Login("admin", "wrong")
+	if !errors.Is(err, qbitorrent.ErrUnauthorized) {
+		t.Fatalf("login with wrong password: got %v, want ErrUnauthorized", err)
+	}
+
+	err = client.Login("admin", "secret")
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+
+	version, err := client.APIVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != (qbitorrent.APIVersion{Major: 2, Minor: 11, Patch: 2}) {
+		t.Errorf("version %s, want 2.11.2", version)
+	}
+
+	_, err = client.GetTags()
+	if err != nil {
+		t.Errorf("request after login: %v", err)
+	}
+}
+
+func TestLoginAgainAfterSessionExpired(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	srv.ExpireSessions()
+
+	err := client.CreateCategory("movies", "/movies")
+	if err != nil {
+		t.Fatalf("request after session expired: %v", err)
+	}
+
+	if got := len(srv.RequestsTo("auth/login")); got != 2 {
+		t.Errorf("logged in %d times, want 2", got)
+	}
+	// the body of the repeated request is sent again
+	if got := srv.Categories()["movies"]; got != "/movies" {
+		t.Errorf("category save path %q, want /movies", got)
+	}
+
+	// the retry carries the new session, not the expired one of the jar
+	requests := srv.RequestsTo("torrents/createCategory")
+	if len(requests) != 2 {
+		t.Fatalf("sent %d requests, want the request and its retry", len(requests))
+	}
+	expired, retry := requests[0].Session, requests[1].Session
+	if expired == "" || retry == "" || retry == expired {
+		t.Errorf("request with session %q retried with %q", expired, retry)
+	}
+}
+
+func TestLogoutStopsLoginAgain(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := client.Logout()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetTags()
+	if !errors.Is(err, qbitorrent.ErrUnauthorized) {
+		t.Errorf("request after logout: got %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestResponseError(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	srv.FailNext("torrents/info", http.StatusInternalServerError)
+
+	_, err := client.GetTorrentList(qbitorrent.TorrentsInfoPostFormdataBody{})
+
+	var responseErr *qbitorrent.ResponseError
+	if !errors.As(err, &responseErr) {
+		t.Fatalf("got %v, want ResponseError", err)
+	}
+	if responseErr.StatusCode != http.StatusInternalServerError || responseErr.Endpoint != "torrents/info" {
+		t.Errorf("got status %d at %s", responseErr.StatusCode, responseErr.Endpoint)
+	}
+	if !errors.Is(err, qbitorrent.ErrBadResponse) {
+		t.Errorf("%v does not match ErrBadResponse", err)
+	}
+
+	_, err = client.GetTorrent(magnetHash)
+	if !errors.Is(err, qbitorrent.ErrNotFound) {
+		t.Errorf("unknown torrent: got %v, want ErrNotFound", err)
+	}
+
+	_, err = client.GetTorrentContent(magnetHash)
+	if !errors.Is(err, qbitorrent.ErrNotFound) {
+		t.Errorf("files of unknown torrent: got %v, want ErrNotFound", err)
+	}
+}
+
+func TestAddTorrentFile(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{
+		Category: makePointer("movies"),
+		Tags:     makePointer("kftm:pending,kp:326"),
+		Rename:   makePointer("Movie (2010)"),
+	})
+	if err != nil {
+		t.Fatalf("add torrent: %v", err)
+	}
+
+	torrent, err := client.GetTorrent(torrentHash(t, client))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *torrent.Name != "Movie (2010)" || *torrent.Category != "movies" {
+		t.Errorf("got name %q, category %q", *torrent.Name, *torrent.Category)
+	}
+	if tags := qbitorrent.TagList(*torrent); !slices.Equal(tags, []string{"kftm:pending", "kp:326"}) {
+		t.Errorf("got tags %v", tags)
+	}
+
+	files, err := client.GetTorrentContent(*torrent.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, *file.Name)
+	}
+	if !slices.Equal(names, []string{"Movie.2010.1080p/movie.mkv", "Movie.2010.1080p/Subs/rus.srt"}) {
+		t.Errorf("got files %v", names)
+	}
+
+	err = addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{})
+	if !errors.Is(err, qbitorrent.ErrConflict) {
+		t.Errorf("add the torrent again: got %v, want ErrConflict", err)
+	}
+
+	err = addFile(t, client, []byte("not a torrent"), qbitorrent.AddTorrentsFiles{})
+	if !errors.Is(err, qbitorrent.ErrUnsupportedMediaType) {
+		t.Errorf("add invalid file: got %v, want ErrUnsupportedMediaType", err)
+	}
+}
+
+func TestAddMagnetWithSlowMetadata(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	srv.SetMagnetMetadata(magnetHash, 300*time.Millisecond, "Movie.2010",
+		qbittest.File{Name: "Movie.2010.mkv", Size: 1 << 30})
+
+	err := client.CreateTorrentFileUrl(qbitorrent.AddTorrentsURLs{
+		Urls: makePointer(qbittest.Magnet(magnetHash, "Movie")),
+	})
+	if err != nil {
+		t.Fatalf("add magnet: %v", err)
+	}
+
+	torrent, err := client.GetTorrent(magnetHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *torrent.State != qbitorrent.TorrentInfoStateMetaDL || *torrent.HasMetadata {
+		t.Errorf("new magnet is %s, has metadata %v", *torrent.State, *torrent.HasMetadata)
+	}
+
+	files, err := client.GetTorrentContent(magnetHash)
+	if err != nil || len(files) != 0 {
+		t.Fatalf("files before metadata: %v, %v", files, err)
+	}
+
+	time.Sleep(400 * time.Millisecond)
+
+	files, err = client.GetTorrentContent(magnetHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || *files[0].Name != "Movie.2010.mkv" {
+		t.Errorf("files after metadata: %v", files)
+	}
+
+	torrent, err = client.GetTorrent(magnetHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *torrent.Name != "Movie.2010" || *torrent.State != qbitorrent.TorrentInfoStateDownloading {
+		t.Errorf("torrent after metadata: %s, %s", *torrent.Name, *torrent.State)
+	}
+}
+
+func TestStopAndStartByVersion(t *testing.T) {
+	tests := []struct {
+		version        string
+		stopEndpoint   string
+		startEndpoint  string
+		stoppedFilter  string
+		addStoppedName string
+	}{
+		{"2.11.2", "torrents/stop", "torrents/start", "stopped", "stopped"},
+		{"2.8.3", "torrents/pause", "torrents/resume", "paused", "paused"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.version, func(t *testing.T) {
+			srv := newServer(t)
+			srv.Version = test.version
+			client := newClient(t, srv)
+
+			err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{
+				Paused: makePointer(qbitorrent.AddTorrentsFilesPausedTrue),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			hash := torrentHash(t, client)
+
+			if got := srv.RequestsTo("torrents/add")[0].Form.Get(test.addStoppedName); got != "true" {
+				t.Errorf("add request has %s=%q", test.addStoppedName, got)
+			}
+
+			// the filter is named paused in both cases and translated for
+			// the server
+			stopped, err := client.GetTorrentList(qbitorrent.TorrentsInfoPostFormdataBody{
+				Filter: makePointer(qbitorrent.TorrentsInfoPostFormdataBodyFilterPaused),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(stopped) != 1 || *stopped[0].State != qbitorrent.TorrentInfoStateStoppedDL {
+				t.Fatalf("stopped torrents: %v", stopped)
+			}
+			requests := srv.RequestsTo("torrents/info")
+			if got := requests[len(requests)-1].Form.Get("filter"); got != test.stoppedFilter {
+				t.Errorf("sent filter %q, want %q", got, test.stoppedFilter)
+			}
+
+			err = client.ResumeTorrents([]string{hash})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = client.PauseTorrents([]string{hash})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(srv.RequestsTo(test.startEndpoint)) != 1 || len(srv.RequestsTo(test.stopEndpoint)) != 1 {
+				t.Errorf("requests to %s and %s expected", test.startEndpoint, test.stopEndpoint)
+			}
+
+			torrent, err := client.GetTorrent(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *torrent.State != qbitorrent.TorrentInfoStateStoppedDL {
+				t.Errorf("state %s, want stoppedDL", *torrent.State)
+			}
+		})
+	}
+}
+
+func TestRenameFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldPath string
+		newPath string
+		folder  bool
+		err     error
+		files   []string
+	}{
+		{
+			name:    "file",
+			oldPath: "Movie.2010.1080p/movie.mkv",
+			newPath: "Movie.2010.1080p/Movie (2010).mkv",
+			files:   []string{"Movie.2010.1080p/Movie (2010).mkv", "Movie.2010.1080p/Subs/rus.srt"},
+		},
+		{
+			name:    "file to another folder",
+			oldPath: "Movie.2010.1080p/movie.mkv",
+			newPath: "Movie.2010.1080p/Movie (2010)/Movie (2010).mkv",
+			files:   []string{"Movie.2010.1080p/Movie (2010)/Movie (2010).mkv", "Movie.2010.1080p/Subs/rus.srt"},
+		},
+		{
+			name:    "folder",
+			oldPath: "Movie.2010.1080p",
+			newPath: "Movie (2010)",
+			folder:  true,
+			files:   []string{"Movie (2010)/movie.mkv", "Movie (2010)/Subs/rus.srt"},
+		},
+		{
+			name:    "missing file",
+			oldPath: "Movie.2010.1080p/sample.mkv",
+			newPath: "Movie.2010.1080p/Movie (2010).mkv",
+			err:     qbitorrent.ErrConflict,
+		},
+		{
+			name:    "file over a folder",
+			oldPath: "Movie.2010.1080p/movie.mkv",
+			newPath: "Movie.2010.1080p/Subs",
+			err:     qbitorrent.ErrConflict,
+		},
+		{
+			name:    "file out of the torrent",
+			oldPath: "Movie.2010.1080p/movie.mkv",
+			newPath: "../movie.mkv",
+			err:     qbitorrent.ErrConflict,
+		},
+		{
+			name:    "folder into itself",
+			oldPath: "Movie.2010.1080p",
+			newPath: "Movie.2010.1080p/Movie",
+			folder:  true,
+			err:     qbitorrent.ErrConflict,
+		},
+		{
+			name:    "folder over a file",
+			oldPath: "Movie.2010.1080p/Subs",
+			newPath: "Movie.2010.1080p/movie.mkv",
+			folder:  true,
+			err:     qbitorrent.ErrConflict,
+		},
+		{
+			name:    "missing folder",
+			oldPath: "Extras",
+			newPath: "Movie (2010)",
+			folder:  true,
+			err:     qbitorrent.ErrConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := newServer(t)
+			client := newClient(t, srv)
+
+			err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			hash := torrentHash(t, client)
+
+			rename := client.RenameFile
+			if test.folder {
+				rename = client.RenameFolder
+			}
+
+			err = rename(qbitorrent.RenameTorrentFiles{Hash: hash, OldPath: test.oldPath, NewPath: test.newPath})
+			if test.err != nil {
+				if !errors.Is(err, test.err) {
+					t.Errorf("got %v, want %v", err, test.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			files, err := client.GetTorrentContent(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			names := make([]string, 0, len(files))
+			for _, file := range files {
+				names = append(names, *file.Name)
+			}
+			if !slices.Equal(names, test.files) {
+				t.Errorf("got files %v, want %v", names, test.files)
+			}
+		})
+	}
+}
+
+func TestCategories(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := client.CreateCategory("movies", "/downloads/movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.CreateCategory("movies", "/other")
+	if !errors.Is(err, qbitorrent.ErrConflict) {
+		t.Errorf("create existing category: got %v, want ErrConflict", err)
+	}
+
+	err = client.EditCategory("movies", "/library/movies")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.EditCategory("series", "/library/series")
+	if !errors.Is(err, qbitorrent.ErrConflict) {
+		t.Errorf("edit missing category: got %v, want ErrConflict", err)
+	}
+
+	categories, err := client.GetAllCategories()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movies, ok := categories["movies"]; !ok || *movies.SavePath != "/library/movies" {
+		t.Errorf("got categories %v", categories)
+	}
+
+	err = client.RemoveCategories([]string{"movies"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(srv.Categories()) != 0 {
+		t.Errorf("categories left: %v", srv.Categories())
+	}
+}
+
+func TestCategoryMovesAutomaticTorrents(t *testing.T) {
+	srv := newServer(t)
+	srv.MoveDelay = 200 * time.Millisecond
+	srv.AddCategory("staging", "/downloads/staging")
+	srv.AddCategory("library", "/library/movies")
+	client := newClient(t, srv)
+
+	err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{
+		AutoTMM:  makePointer(true),
+		Category: makePointer("staging"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := torrentHash(t, client)
+
+	err = client.SetCategory(qbitorrent.TorrentsSetCategoryPostFormdataBody{
+		Hashes:   hash,
+		Category: makePointer("library"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	torrent, err := client.GetTorrent(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *torrent.State != qbitorrent.TorrentInfoStateMoving || *torrent.SavePath != "/downloads/staging" {
+		t.Errorf("torrent is %s in %s while moving", *torrent.State, *torrent.SavePath)
+	}
+
+	time.Sleep(300 * time.Millisecond)
+
+	torrent, err = client.GetTorrent(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *torrent.State != qbitorrent.TorrentInfoStateDownloading || *torrent.SavePath != "/library/movies" {
+		t.Errorf("torrent is %s in %s after moving", *torrent.State, *torrent.SavePath)
+	}
+
+	err = client.SetCategory(qbitorrent.TorrentsSetCategoryPostFormdataBody{
+		Hashes:   hash,
+		Category: makePointer("unknown"),
+	})
+	if !errors.Is(err, qbitorrent.ErrConflict) {
+		t.Errorf("set unknown category: got %v, want ErrConflict", err)
+	}
+}
+
+func TestTags(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := client.CreateTags([]string{"kftm:pending", "kftm:renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := torrentHash(t, client)
+
+	err = client.AddTags([]string{hash}, []string{"kftm:pending", "kp:326"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.RemoveTags([]string{hash}, []string{"kftm:pending"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	torrent, err := client.GetTorrent(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags := qbitorrent.TagList(*torrent); !slices.Equal(tags, []string{"kp:326"}) {
+		t.Errorf("torrent tags %v, want [kp:326]", tags)
+	}
+
+	tagged, err := client.GetTorrentList(qbitorrent.TorrentsInfoPostFormdataBody{Tag: makePointer("kp:326")})
+	if err != nil || len(tagged) != 1 {
+		t.Errorf("torrents with tag: %v, %v", tagged, err)
+	}
+
+	err = client.DeleteTags([]string{"kp:326"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tags, err := client.GetTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(tags, []string{"kftm:pending", "kftm:renamed"}) {
+		t.Errorf("tags %v", tags)
+	}
+}
+
+func TestSetShareLimitsByVersion(t *testing.T) {
+	for _, version := range []string{"2.8.3", "2.11.2"} {
+		t.Run(version, func(t *testing.T) {
+			srv := newServer(t)
+			srv.Version = version
+			client := newClient(t, srv)
+
+			err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			hash := torrentHash(t, client)
+
+			err = client.SetShareLimits(qbitorrent.TorrentsSetShareLimitsPostFormdataBody{
+				Hashes:           hash,
+				RatioLimit:       makePointer(float32(2)),
+				SeedingTimeLimit: makePointer(-1),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			torrent, _ := srv.Torrent(hash)
+			if torrent.RatioLimit != 2 || torrent.SeedingTimeLimit != -1 {
+				t.Errorf("limits %v and %v", torrent.RatioLimit, torrent.SeedingTimeLimit)
+			}
+		})
+	}
+}
+
+func TestDeleteTorrents(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.DeleteTorrents([]string{torrentHash(t, client)}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	torrents, err := client.GetTorrentList(qbitorrent.TorrentsInfoPostFormdataBody{})
+	if err != nil || len(torrents) != 0 {
+		t.Errorf("torrents after delete: %v, %v", torrents, err)
+	}
+}
+
+func TestMaindataThroughAPI(t *testing.T) {
+	srv := newServer(t)
+	client := newClient(t, srv)
+
+	err := addFile(t, client, movieTorrent(), qbitorrent.AddTorrentsFiles{Category: makePointer("movies")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := torrentHash(t, client)
+
+	// the generated client shares the session, and logs in again too
+	srv.ExpireSessions()
+
+	resp, err := client.API().SyncMaindataPostWithFormdataBodyWithResponse(context.Background(),
+		api.SyncMaindataPostFormdataRequestBody{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.JSON200 == nil || resp.JSON200.Torrents == nil {
+		t.Fatalf("no maindata in %s", resp.Body)
+	}
+
+	torrent, ok := (*resp.JSON200.Torrents)[hash]
+	if !ok || *torrent.Category != "movies" {
+		t.Errorf("maindata torrents %v", *resp.JSON200.Torrents)
+	}
+	if _, ok := (*resp.JSON200.Categories)["movies"]; !ok {
+		t.Errorf("maindata categories %v", *resp.JSON200.Categories)
+	}
+}
+
+func makePointer[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,71 @@
+package qbitorrent
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"mime/multipart"
+	"slices"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// The facade sends bodies with the generated WithFormdataBody methods. The
+// spec describes list fields the way qbitorrent reads them, as one value
+// joined by a separator, so callers join lists with joinHashes and joinList.
+//
+// torrents/add is the only multipart endpoint. The generated client has no
+// builder for multipart bodies, so the fields are encoded with the same
+// runtime.MarshalForm and written next to the file parts.
+
+// joinHashes joins hashes for the hashes field of request bodies.
+func joinHashes(hashes []string) string {
+	return strings.Join(hashes, "|")
+}
+
+// joinList joins tags and other lists qbitorrent reads comma separated.
+func joinList(items []string) string {
+	return strings.Join(items, ",")
+}
+
+// encodeMultipart encodes fields of obj as multipart form fields and files
+// as parts named fileField. It returns the body and its content type.
+func encodeMultipart(obj any, fileField string, files []openapi_types.File) (io.Reader, string, error) {
+	fields, err := runtime.MarshalForm(obj, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("encode form: %w", err)
+	}
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	for _, key := range slices.Sorted(maps.Keys(fields)) {
+		for _, value := range fields[key] {
+			writer.WriteField(key, value)
+		}
+	}
+
+	for _, file := range files {
+		data, err := file.Bytes()
+		if err != nil {
+			return nil, "", fmt.Errorf("read file %s: %w", file.Filename(), err)
+		}
+
+		part, err := writer.CreateFormFile(fileField, file.Filename())
+		if err != nil {
+			return nil, "", fmt.Errorf("create form file: %w", err)
+		}
+
+		_, err = part.Write(data)
+		if err != nil {
+			return nil, "", fmt.Errorf("write form file: %w", err)
+		}
+	}
+
+	writer.Close()
+
+	return body, writer.FormDataContentType(), nil
+}
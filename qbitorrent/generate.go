@@ -1,2 +0,0 @@
-//go:generate go tool oapi-codegen -config config.yaml ./specs/v2.8.3/openapi.yaml
-package qbitorrent
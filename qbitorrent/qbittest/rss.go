@@ -0,0 +1,47 @@
+package qbittest
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// RssRules returns rss rules by their names.
+func (s *Server) RssRules() map[string]qbitorrent.RssRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rules := make(map[string]qbitorrent.RssRule, len(s.rssRules))
+	for name, data := range s.rssRules {
+		var rule qbitorrent.RssRule
+		json.Unmarshal(data, &rule)
+		rules[name] = rule
+	}
+
+	return rules
+}
+
+func (s *Server) listRssRules(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, maps.Clone(s.rssRules))
+}
+
+// setRssRule stores the rule definition as it is sent, qbitorrent answers
+// 200 to any json object.
+func (s *Server) setRssRule(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("ruleName")
+	data := json.RawMessage(r.Form.Get("ruleDef"))
+
+	var rule map[string]any
+	if name == "" || json.Unmarshal(data, &rule) != nil {
+		http.Error(w, "Invalid data format", http.StatusBadRequest)
+		return
+	}
+
+	s.rssRules[name] = data
+}
+
+func (s *Server) removeRssRule(w http.ResponseWriter, r *http.Request) {
+	delete(s.rssRules, r.Form.Get("ruleName"))
+}
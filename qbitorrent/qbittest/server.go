@@ -0,0 +1,359 @@
+// Package qbittest runs a fake qbitorrent WebAPI in process for tests. It
+// keeps torrents, categories and tags in memory, follows the paths and
+// status codes of the real server and can script slow metadata, expired
+// sessions and failures.
+package qbittest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shadream/kftm/qbitorrent"
+	"github.com/shadream/kftm/qbitorrent/api"
+)
+
+const (
+	apiPrefix = "/api/v2/"
+	// qbitorrent 5.0
+	defaultVersion = "2.11.2"
+	sessionCookie  = "SID"
+)
+
+var (
+	// pause and resume are renamed to stop and start in endpoints, states
+	// and filters
+	versionStopStart = qbitorrent.APIVersion{Major: 2, Minor: 11}
+	// setShareLimits requires inactiveSeedingTimeLimit
+	versionInactiveSeeding = qbitorrent.APIVersion{Major: 2, Minor: 9, Patch: 2}
+)
+
+// Server is a fake qbitorrent. Fields are read on every request, set them
+// before the client is used.
+type Server struct {
+	*httptest.Server
+
+	// Credentials accepted by auth/login, authentication is bypassed when
+	// Username is empty
+	Username string
+	Password string
+	// WebAPI version, endpoints and state names follow it. 2.11.2 by default
+	Version string
+	// Save path of torrents without category save path
+	DefaultSavePath string
+	// Create empty files of torrents on disk and rename and move them like
+	// qbitorrent does. Save paths must be local folders then
+	WriteFiles bool
+	// How long a torrent stays in moving state after its location changes
+	MoveDelay time.Duration
+
+	mu         sync.Mutex
+	sessions   map[string]bool
+	torrents   []*Torrent
+	categories map[string]string
+	tags       map[string]bool
+	// metadata of magnets that are not added yet, by hash
+	metadata map[string]magnetMetadata
+	// scripted status codes by endpoint
+	failures map[string][]int
+	// definitions of rss rules by name, as they were sent
+	rssRules map[string]json.RawMessage
+	requests []Request
+	rid      int64
+}
+
+// Request is a request received by the server.
+type Request struct {
+	// Path relative to the api root, like "torrents/info"
+	Endpoint string
+	// Form values, multipart ones included
+	Form url.Values
+	// Names of uploaded files
+	Files []string
+	// Session cookie the request was authorized with, empty without one
+	Session string
+}
+
+// NewServer starts a server, it must be closed with Close.
+func NewServer() *Server {
+	s := &Server{
+		Version:    defaultVersion,
+		sessions:   map[string]bool{},
+		categories: map[string]string{},
+		tags:       map[string]bool{},
+		metadata:   map[string]magnetMetadata{},
+		failures:   map[string][]int{},
+		rssRules:   map[string]json.RawMessage{},
+	}
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// ExpireSessions forgets all sessions like qbitorrent does on timeout or
+// restart, requests get 403 until the client logs in again.
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.sessions)
+}
+
+// FailNext makes the next request to the endpoint fail with the status.
+// Calls for the same endpoint are queued.
+func (s *Server) FailNext(endpoint string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[endpoint] = append(s.failures[endpoint], status)
+}
+
+// Requests returns requests received so far, the failed ones included.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// RequestsTo returns requests to the endpoint.
+func (s *Server) RequestsTo(endpoint string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var requests []Request
+	for _, req := range s.requests {
+		if req.Endpoint == endpoint {
+			requests = append(requests, req)
+		}
+	}
+
+	return requests
+}
+
+// Categories returns save paths by category name.
+func (s *Server) Categories() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return maps.Clone(s.categories)
+}
+
+// AddCategory creates a category without a request.
+func (s *Server) AddCategory(name, savePath string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.categories[name] = savePath
+}
+
+// Tags returns sorted tags known to the server.
+func (s *Server) Tags() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(s.tags))
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	endpoint, ok := strings.CutPrefix(r.URL.Path, apiPrefix)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	var err error
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		err = r.ParseMultipartForm(32 << 20)
+	} else {
+		err = r.ParseForm()
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	req := Request{Endpoint: endpoint, Form: maps.Clone(r.Form)}
+	if r.MultipartForm != nil {
+		for _, headers := range r.MultipartForm.File {
+			for _, header := range headers {
+				req.Files = append(req.Files, header.Filename)
+			}
+		}
+	}
+	if cookie, err := r.Cookie(sessionCookie); err == nil {
+		req.Session = cookie.Value
+	}
+	s.requests = append(s.requests, req)
+
+	if statuses := s.failures[endpoint]; len(statuses) != 0 {
+		s.failures[endpoint] = statuses[1:]
+		http.Error(w, http.StatusText(statuses[0]), statuses[0])
+		return
+	}
+
+	if endpoint != "auth/login" && !s.authorized(r) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
+	handler, ok := s.handlers()[endpoint]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.update(time.Now())
+	handler(w, r)
+}
+
+// handlers returns endpoints of the server version.
+func (s *Server) handlers() map[string]http.HandlerFunc {
+	handlers := map[string]http.HandlerFunc{
+		"auth/login":                 s.login,
+		"auth/logout":                s.logout,
+		"app/webapiVersion":          s.webapiVersion,
+		"sync/maindata":              s.maindata,
+		"torrents/info":              s.info,
+		"torrents/files":             s.files,
+		"torrents/add":               s.add,
+		"torrents/delete":            s.delete,
+		"torrents/rename":            s.rename,
+		"torrents/renameFile":        s.renameFile,
+		"torrents/renameFolder":      s.renameFolder,
+		"torrents/filePrio":          s.filePrio,
+		"torrents/categories":        s.listCategories,
+		"torrents/createCategory":    s.createCategory,
+		"torrents/editCategory":      s.editCategory,
+		"torrents/removeCategories":  s.removeCategories,
+		"torrents/setCategory":       s.setCategory,
+		"torrents/setAutoManagement": s.setAutoManagement,
+		"torrents/setLocation":       s.setLocation,
+		"torrents/setShareLimits":    s.setShareLimits,
+		"torrents/tags":              s.listTags,
+		"rss/rules":                  s.listRssRules,
+		"rss/setRule":                s.setRssRule,
+		"rss/removeRule":             s.removeRssRule,
+		"torrents/createTags":        s.createTags,
+		"torrents/deleteTags":        s.deleteTags,
+		"torrents/addTags":           s.addTags,
+		"torrents/removeTags":        s.removeTags,
+	}
+
+	if s.stopStart() {
+		handlers["torrents/stop"] = s.stop
+		handlers["torrents/start"] = s.start
+	} else {
+		handlers["torrents/pause"] = s.stop
+		handlers["torrents/resume"] = s.start
+	}
+
+	return handlers
+}
+
+func (s *Server) apiVersion() qbitorrent.APIVersion {
+	version, err := qbitorrent.ParseAPIVersion(s.Version)
+	if err != nil {
+		version, _ = qbitorrent.ParseAPIVersion(defaultVersion)
+	}
+
+	return version
+}
+
+func (s *Server) stopStart() bool {
+	return s.apiVersion().AtLeast(versionStopStart)
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.Username == "" {
+		return true
+	}
+
+	cookie, err := r.Cookie(sessionCookie)
+
+	return err == nil && s.sessions[cookie.Value]
+}
+
+// login answers 200 with "Fails." on wrong credentials, like qbitorrent.
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	if r.Form.Get("username") != s.Username || r.Form.Get("password") != s.Password {
+		writeText(w, "Fails.")
+		return
+	}
+
+	sid := make([]byte, 16)
+	rand.Read(sid)
+	session := hex.EncodeToString(sid)
+	s.sessions[session] = true
+
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: session, Path: "/", HttpOnly: true})
+	writeText(w, "Ok.")
+}
+
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(sessionCookie); err == nil {
+		delete(s.sessions, cookie.Value)
+	}
+}
+
+func (s *Server) webapiVersion(w http.ResponseWriter, r *http.Request) {
+	writeText(w, s.apiVersion().String())
+}
+
+// maindata always answers with a full update.
+func (s *Server) maindata(w http.ResponseWriter, r *http.Request) {
+	s.rid++
+
+	torrents := make(map[string]qbitorrent.TorrentInfo, len(s.torrents))
+	for _, t := range s.torrents {
+		torrents[t.Hash] = t.info(s.stopStart())
+	}
+
+	writeJSON(w, api.MainData{
+		Rid:        &s.rid,
+		FullUpdate: ptr(true),
+		Torrents:   &torrents,
+		Categories: ptr(s.categoryList()),
+		Tags:       ptr(slices.Sorted(maps.Keys(s.tags))),
+	})
+}
+
+func writeText(w http.ResponseWriter, text string) {
+	w.Header().Set("Content-Type", "text/plain; charset=UTF-8")
+	w.Write([]byte(text))
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+// splitList splits a form value, empty value is an empty list.
+func splitList(value, separator string) []string {
+	if value == "" {
+		return nil
+	}
+
+	return strings.Split(value, separator)
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
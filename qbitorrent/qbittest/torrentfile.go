@@ -0,0 +1,86 @@
+package qbittest
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// TorrentFile builds a v1 .torrent file. A torrent with a single file named
+// like the torrent is a single file torrent, otherwise names of files start
+// with the torrent folder, as qbitorrent lists them.
+func TorrentFile(name string, files ...File) []byte {
+	info := map[string]any{
+		"name":         name,
+		"piece length": int64(1 << 18),
+		// pieces are not checked, kftm and the server only read hashes and
+		// files
+		"pieces": strings.Repeat("\x00", 20),
+	}
+
+	if len(files) == 1 && files[0].Name == name {
+		info["length"] = files[0].Size
+	} else {
+		list := make([]any, 0, len(files))
+		for _, file := range files {
+			rest, _ := strings.CutPrefix(file.Name, name+"/")
+			parts := make([]any, 0)
+			for _, part := range strings.Split(rest, "/") {
+				parts = append(parts, part)
+			}
+			list = append(list, map[string]any{"length": file.Size, "path": parts})
+		}
+		info["files"] = list
+	}
+
+	var buf bytes.Buffer
+	encode(&buf, map[string]any{
+		"announce": "http://tracker.example/announce",
+		"info":     info,
+	})
+
+	return buf.Bytes()
+}
+
+// Magnet returns a magnet link with the hex v1 hash and the display name.
+func Magnet(hash, name string) string {
+	link := "magnet:?xt=urn:btih:" + hash
+	if name != "" {
+		link += "&dn=" + url.QueryEscape(name)
+	}
+
+	return link
+}
+
+// encode writes value in bencode, dictionary keys are sorted as the format
+// requires.
+func encode(buf *bytes.Buffer, value any) {
+	switch v := value.(type) {
+	case int64:
+		fmt.Fprintf(buf, "i%de", v)
+	case string:
+		fmt.Fprintf(buf, "%d:%s", len(v), v)
+	case []any:
+		buf.WriteByte('l')
+		for _, item := range v {
+			encode(buf, item)
+		}
+		buf.WriteByte('e')
+	case map[string]any:
+		buf.WriteByte('d')
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			encode(buf, key)
+			encode(buf, v[key])
+		}
+		buf.WriteByte('e')
+	default:
+		panic(fmt.Sprintf("bencode: unsupported type %T", value))
+	}
+}
@@ -0,0 +1,921 @@
+package qbittest
+
+import (
+	"cmp"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shadream/kftm/metainfo"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// Torrent is a torrent of the fake server.
+type Torrent struct {
+	Hash     string
+	Name     string
+	Category string
+	Tags     []string
+	SavePath string
+	AutoTMM  bool
+	// Current state name, it is reported as pausedDL and pausedUP to
+	// versions before 2.11
+	State    qbitorrent.TorrentInfoState
+	Progress float32
+	Ratio    float32
+	// Seconds
+	SeedingTime int64
+	Tracker     string
+	// -2 is the global limit, -1 is no limit. Torrents added by requests
+	// have -2, AddTorrent keeps the given values
+	RatioLimit float32
+	// Minutes
+	SeedingTimeLimit         int64
+	InactiveSeedingTimeLimit int64
+	// Files as qbitorrent lists them, nil until metadata is received
+	Files []File
+
+	// metadata of a magnet is received at this time
+	metadataAt time.Time
+	// location the torrent is moving to and when it finishes
+	moveTo  string
+	movedAt time.Time
+	// state to return to after moving
+	movedState qbitorrent.TorrentInfoState
+	renamed    bool
+}
+
+// File is a file of a torrent. Name is the slash separated path in the save
+// path, multi-file torrents start with the torrent folder.
+type File struct {
+	Name     string
+	Size     int64
+	Priority int
+}
+
+type magnetMetadata struct {
+	delay time.Duration
+	name  string
+	files []File
+}
+
+// AddTorrent puts the torrent into the server without a request. Files are
+// not written to disk.
+func (s *Server) AddTorrent(t Torrent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range t.Tags {
+		s.tags[tag] = true
+	}
+
+	t.Tags = slices.Clone(t.Tags)
+	t.Files = slices.Clone(t.Files)
+	s.torrents = append(s.torrents, &t)
+}
+
+// Torrent returns a copy of the torrent with the hash.
+func (s *Server) Torrent(hash string) (Torrent, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.update(time.Now())
+
+	t := s.find(hash)
+	if t == nil {
+		return Torrent{}, false
+	}
+
+	copied := *t
+	copied.Tags = slices.Clone(t.Tags)
+	copied.Files = slices.Clone(t.Files)
+
+	return copied, true
+}
+
+// UpdateTorrent changes the torrent with the hash, e.g. to finish its
+// download. It reports false when there is no such torrent.
+func (s *Server) UpdateTorrent(hash string, update func(t *Torrent)) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.find(hash)
+	if t == nil {
+		return false
+	}
+
+	update(t)
+	for _, tag := range t.Tags {
+		s.tags[tag] = true
+	}
+
+	return true
+}
+
+// SetMagnetMetadata sets what qbitorrent learns from peers about the magnet
+// with the hash: the torrent name and files, delay after the magnet is
+// added. Magnets without metadata stay in metaDL state forever.
+func (s *Server) SetMagnetMetadata(hash string, delay time.Duration, name string, files ...File) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.metadata[strings.ToLower(hash)] = magnetMetadata{delay: delay, name: name, files: slices.Clone(files)}
+}
+
+func (s *Server) find(hash string) *Torrent {
+	for _, t := range s.torrents {
+		if strings.EqualFold(t.Hash, hash) {
+			return t
+		}
+	}
+
+	return nil
+}
+
+// update receives scheduled metadata and finishes moves.
+func (s *Server) update(now time.Time) {
+	for _, t := range s.torrents {
+		if t.Files == nil && !t.metadataAt.IsZero() && !now.Before(t.metadataAt) {
+			metadata := s.metadata[t.Hash]
+			if !t.renamed {
+				t.Name = metadata.name
+			}
+			t.Files = slices.Clone(metadata.files)
+			if t.State == qbitorrent.TorrentInfoStateMetaDL {
+				t.State = qbitorrent.TorrentInfoStateDownloading
+			}
+			s.writeFiles(t)
+		}
+
+		if t.moveTo != "" && !now.Before(t.movedAt) {
+			s.moveFiles(t, t.moveTo)
+			t.SavePath = t.moveTo
+			t.moveTo = ""
+			t.State = t.movedState
+		}
+	}
+}
+
+// info converts the torrent to the torrents/info item of the version.
+func (t *Torrent) info(stopStart bool) qbitorrent.TorrentInfo {
+	state := t.State
+	if !stopStart {
+		switch state {
+		case qbitorrent.TorrentInfoStateStoppedDL:
+			state = qbitorrent.TorrentInfoStatePausedDL
+		case qbitorrent.TorrentInfoStateStoppedUP:
+			state = qbitorrent.TorrentInfoStatePausedUP
+		}
+	}
+
+	var size int64
+	for _, file := range t.Files {
+		if file.Priority != 0 {
+			size += file.Size
+		}
+	}
+
+	maxRatio := t.RatioLimit
+	if maxRatio == -2 {
+		maxRatio = -1
+	}
+	maxSeedingTime := t.SeedingTimeLimit
+	if maxSeedingTime >= 0 {
+		maxSeedingTime *= 60
+	} else {
+		maxSeedingTime = -1
+	}
+
+	return qbitorrent.TorrentInfo{
+		Hash:             ptr(t.Hash),
+		Name:             ptr(t.Name),
+		Category:         ptr(t.Category),
+		Tags:             ptr(strings.Join(t.Tags, ", ")),
+		SavePath:         ptr(t.SavePath),
+		ContentPath:      ptr(t.contentPath()),
+		AutoTmm:          ptr(t.AutoTMM),
+		State:            &state,
+		Progress:         ptr(t.Progress),
+		Ratio:            ptr(t.Ratio),
+		SeedingTime:      ptr(t.SeedingTime),
+		Tracker:          ptr(t.Tracker),
+		RatioLimit:       ptr(t.RatioLimit),
+		SeedingTimeLimit: ptr(t.SeedingTimeLimit),
+		MaxRatio:         ptr(maxRatio),
+		MaxSeedingTime:   ptr(maxSeedingTime),
+		HasMetadata:      ptr(t.Files != nil),
+		Size:             ptr(size),
+		TotalSize:        ptr(size),
+		NumSeeds:         ptr(int64(0)),
+		NumLeechs:        ptr(int64(0)),
+	}
+}
+
+// contentPath is the torrent folder or the file of a single file torrent.
+func (t *Torrent) contentPath() string {
+	if len(t.Files) == 0 {
+		return path.Join(t.SavePath, t.Name)
+	}
+
+	root, _, nested := strings.Cut(t.Files[0].Name, "/")
+	if !nested {
+		return path.Join(t.SavePath, t.Files[0].Name)
+	}
+
+	return path.Join(t.SavePath, root)
+}
+
+// torrentsByForm returns torrents listed in the hashes field, "all" selects
+// every torrent. Unknown hashes are skipped.
+func (s *Server) torrentsByForm(r *http.Request) []*Torrent {
+	hashes := splitList(r.Form.Get("hashes"), "|")
+	if slices.Contains(hashes, "all") {
+		return s.torrents
+	}
+
+	var torrents []*Torrent
+	for _, hash := range hashes {
+		if t := s.find(hash); t != nil {
+			torrents = append(torrents, t)
+		}
+	}
+
+	return torrents
+}
+
+// torrentByForm returns the torrent from the hash field or answers 404.
+func (s *Server) torrentByForm(w http.ResponseWriter, r *http.Request) *Torrent {
+	t := s.find(r.Form.Get("hash"))
+	if t == nil {
+		http.Error(w, "Torrent hash was not found", http.StatusNotFound)
+	}
+
+	return t
+}
+
+func (s *Server) info(w http.ResponseWriter, r *http.Request) {
+	torrents := s.torrents
+	if r.Form.Has("hashes") {
+		torrents = s.torrentsByForm(r)
+	}
+
+	list := []qbitorrent.TorrentInfo{}
+	for _, t := range torrents {
+		if r.Form.Has("category") && t.Category != r.Form.Get("category") {
+			continue
+		}
+		if r.Form.Has("tag") && !slices.Contains(t.Tags, r.Form.Get("tag")) {
+			continue
+		}
+		if !s.matchesFilter(t, r.Form.Get("filter")) {
+			continue
+		}
+
+		list = append(list, t.info(s.stopStart()))
+	}
+
+	writeJSON(w, list)
+}
+
+// matchesFilter knows filter names of the server version only, names of the
+// other version match nothing.
+func (s *Server) matchesFilter(t *Torrent, filter string) bool {
+	stopped := t.State == qbitorrent.TorrentInfoStateStoppedDL || t.State == qbitorrent.TorrentInfoStateStoppedUP
+
+	stoppedFilter, runningFilter := "paused", "resumed"
+	if s.stopStart() {
+		stoppedFilter, runningFilter = "stopped", "running"
+	}
+
+	switch filter {
+	case "", "all":
+		return true
+	case stoppedFilter:
+		return stopped
+	case runningFilter:
+		return !stopped
+	case "completed":
+		return t.Progress >= 1
+	case "downloading":
+		return t.Progress < 1 && !stopped
+	case "moving":
+		return t.State == qbitorrent.TorrentInfoStateMoving
+	default:
+		return false
+	}
+}
+
+func (s *Server) files(w http.ResponseWriter, r *http.Request) {
+	t := s.torrentByForm(w, r)
+	if t == nil {
+		return
+	}
+
+	files := []qbitorrent.TorrentsFiles{}
+	for i, file := range t.Files {
+		files = append(files, qbitorrent.TorrentsFiles{
+			Index:    ptr(int64(i)),
+			Name:     ptr(file.Name),
+			Size:     ptr(file.Size),
+			Priority: ptr(qbitorrent.TorrentsFilesPriority(file.Priority)),
+			Progress: ptr(t.Progress),
+		})
+	}
+
+	writeJSON(w, files)
+}
+
+// add accepts magnets in urls and .torrent files in torrents parts. Already
+// added torrents are answered with 409 and invalid ones with 415, like
+// qbitorrent 5 does.
+func (s *Server) add(w http.ResponseWriter, r *http.Request) {
+	var added []*Torrent
+	for _, link := range splitList(r.Form.Get("urls"), "\n") {
+		magnet, err := metainfo.ParseMagnet(link)
+		if err != nil {
+			http.Error(w, "Fails.", http.StatusUnsupportedMediaType)
+			return
+		}
+
+		t := &Torrent{
+			Hash:  magnet.Hash(),
+			Name:  cmp.Or(magnet.Name, magnet.Hash()),
+			State: qbitorrent.TorrentInfoStateMetaDL,
+		}
+		if metadata, ok := s.metadata[t.Hash]; ok {
+			t.metadataAt = time.Now().Add(metadata.delay)
+		}
+		added = append(added, t)
+	}
+
+	if r.MultipartForm != nil {
+		for _, header := range r.MultipartForm.File["torrents"] {
+			file, err := header.Open()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			data, err := io.ReadAll(file)
+			file.Close()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			info, err := metainfo.Parse(data)
+			if err != nil {
+				http.Error(w, "Fails.", http.StatusUnsupportedMediaType)
+				return
+			}
+
+			t := &Torrent{
+				Hash:  info.Hash(),
+				Name:  info.Name,
+				State: qbitorrent.TorrentInfoStateDownloading,
+				Files: layoutFiles(info, r.Form),
+			}
+			added = append(added, t)
+		}
+	}
+
+	if len(added) == 0 {
+		http.Error(w, "Fails.", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	duplicates := 0
+	for _, t := range added {
+		if s.find(t.Hash) != nil {
+			duplicates++
+			continue
+		}
+
+		s.applyAddOptions(t, r)
+		s.torrents = append(s.torrents, t)
+		s.writeFiles(t)
+	}
+
+	if duplicates == len(added) {
+		http.Error(w, "Fails.", http.StatusConflict)
+		return
+	}
+
+	writeText(w, "Ok.")
+}
+
+func (s *Server) applyAddOptions(t *Torrent, r *http.Request) {
+	t.RatioLimit, t.SeedingTimeLimit, t.InactiveSeedingTimeLimit = -2, -2, -2
+
+	t.Category = r.Form.Get("category")
+	if _, ok := s.categories[t.Category]; t.Category != "" && !ok {
+		s.categories[t.Category] = ""
+	}
+
+	for _, tag := range splitList(r.Form.Get("tags"), ",") {
+		tag = strings.TrimSpace(tag)
+		if !slices.Contains(t.Tags, tag) {
+			t.Tags = append(t.Tags, tag)
+		}
+		s.tags[tag] = true
+	}
+
+	if name := r.Form.Get("rename"); name != "" {
+		t.Name = name
+		t.renamed = true
+	}
+
+	t.AutoTMM = r.Form.Get("autoTMM") == "true"
+	t.SavePath = cmp.Or(r.Form.Get("savepath"), s.DefaultSavePath)
+	if t.AutoTMM {
+		t.SavePath = s.categorySavePath(t.Category)
+	}
+
+	stoppedField := "paused"
+	if s.stopStart() {
+		stoppedField = "stopped"
+	}
+	if r.Form.Get(stoppedField) == "true" {
+		t.State = qbitorrent.TorrentInfoStateStoppedDL
+	}
+}
+
+// layoutFiles applies root_folder or contentLayout of the add request to
+// files of the torrent.
+func layoutFiles(info *metainfo.MetaInfo, form map[string][]string) []File {
+	layout := "Original"
+	if values := form["contentLayout"]; len(values) != 0 {
+		layout = values[0]
+	}
+	if values := form["root_folder"]; len(values) != 0 {
+		layout = map[string]string{"true": "Subfolder", "false": "NoSubfolder"}[values[0]]
+	}
+
+	folder := strings.TrimSuffix(info.Name, path.Ext(info.Name))
+
+	files := make([]File, 0, len(info.Files))
+	for _, file := range info.Files {
+		name := file.Path
+		switch {
+		case layout == "NoSubfolder" && info.MultiFile:
+			_, name, _ = strings.Cut(name, "/")
+		case layout == "Subfolder" && !info.MultiFile:
+			name = folder + "/" + name
+		}
+
+		files = append(files, File{Name: name, Size: file.Length, Priority: 1})
+	}
+
+	return files
+}
+
+func (s *Server) categorySavePath(category string) string {
+	if savePath := s.categories[category]; savePath != "" {
+		return savePath
+	}
+
+	return path.Join(s.DefaultSavePath, category)
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request) {
+	deleteFiles := r.Form.Get("deleteFiles") == "true"
+	for _, t := range s.torrentsByForm(r) {
+		if deleteFiles {
+			s.removeFiles(t)
+		}
+		s.torrents = slices.DeleteFunc(s.torrents, func(other *Torrent) bool { return other == t })
+	}
+}
+
+func (s *Server) rename(w http.ResponseWriter, r *http.Request) {
+	t := s.torrentByForm(w, r)
+	if t == nil {
+		return
+	}
+
+	name := strings.TrimSpace(r.Form.Get("name"))
+	if name == "" {
+		http.Error(w, "Incorrect torrent name", http.StatusConflict)
+		return
+	}
+
+	t.Name = name
+	t.renamed = true
+}
+
+// renameFile answers 409 when the new path is invalid or used by another
+// file or folder, or the old path is not a file of the torrent.
+func (s *Server) renameFile(w http.ResponseWriter, r *http.Request) {
+	t := s.torrentByForm(w, r)
+	if t == nil {
+		return
+	}
+
+	oldPath, newPath := r.Form.Get("oldPath"), r.Form.Get("newPath")
+	if !validPath(newPath) {
+		http.Error(w, "Invalid new path", http.StatusConflict)
+		return
+	}
+
+	index := slices.IndexFunc(t.Files, func(file File) bool { return file.Name == oldPath })
+	if index == -1 {
+		http.Error(w, "Invalid old path", http.StatusConflict)
+		return
+	}
+	if oldPath == newPath {
+		return
+	}
+	if t.pathUsed(newPath) {
+		http.Error(w, "New path is already in use", http.StatusConflict)
+		return
+	}
+
+	err := s.renameOnDisk(t, oldPath, newPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	t.Files[index].Name = newPath
+}
+
+// renameFolder moves every file under the old folder, the new folder must
+// not exist.
+func (s *Server) renameFolder(w http.ResponseWriter, r *http.Request) {
+	t := s.torrentByForm(w, r)
+	if t == nil {
+		return
+	}
+
+	oldPath, newPath := strings.TrimSuffix(r.Form.Get("oldPath"), "/"), strings.TrimSuffix(r.Form.Get("newPath"), "/")
+	if !validPath(newPath) {
+		http.Error(w, "Invalid new path", http.StatusConflict)
+		return
+	}
+
+	if !slices.ContainsFunc(t.Files, func(file File) bool { return strings.HasPrefix(file.Name, oldPath+"/") }) {
+		http.Error(w, "Invalid old path", http.StatusConflict)
+		return
+	}
+	if oldPath == newPath {
+		return
+	}
+	if t.pathUsed(newPath) || strings.HasPrefix(newPath, oldPath+"/") {
+		http.Error(w, "New path is already in use", http.StatusConflict)
+		return
+	}
+
+	err := s.renameOnDisk(t, oldPath, newPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	for i, file := range t.Files {
+		if rest, ok := strings.CutPrefix(file.Name, oldPath+"/"); ok {
+			t.Files[i].Name = newPath + "/" + rest
+		}
+	}
+}
+
+// pathUsed reports whether the path is a file or a folder of the torrent.
+func (t *Torrent) pathUsed(name string) bool {
+	return slices.ContainsFunc(t.Files, func(file File) bool {
+		return file.Name == name || strings.HasPrefix(file.Name, name+"/") || strings.HasPrefix(name, file.Name+"/")
+	})
+}
+
+// validPath accepts relative slash separated paths without empty, "." and
+// ".." parts.
+func validPath(name string) bool {
+	if name == "" || strings.ContainsAny(name, `\:`) {
+		return false
+	}
+
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || part == "." || part == ".." || strings.TrimSpace(part) != part {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) filePrio(w http.ResponseWriter, r *http.Request) {
+	t := s.torrentByForm(w, r)
+	if t == nil {
+		return
+	}
+	if t.Files == nil {
+		http.Error(w, "Torrent's metadata has not yet downloaded", http.StatusConflict)
+		return
+	}
+
+	priority, err := strconv.Atoi(r.Form.Get("priority"))
+	if err != nil || !slices.Contains([]int{0, 1, 6, 7}, priority) {
+		http.Error(w, "Priority is not valid", http.StatusBadRequest)
+		return
+	}
+
+	var indexes []int
+	for _, id := range splitList(r.Form.Get("id"), "|") {
+		index, err := strconv.Atoi(id)
+		if err != nil || index < 0 || index >= len(t.Files) {
+			http.Error(w, "File IDs are not valid", http.StatusConflict)
+			return
+		}
+		indexes = append(indexes, index)
+	}
+
+	for _, index := range indexes {
+		t.Files[index].Priority = priority
+	}
+}
+
+func (s *Server) categoryList() map[string]qbitorrent.TorrentsCategory {
+	categories := make(map[string]qbitorrent.TorrentsCategory, len(s.categories))
+	for name, savePath := range s.categories {
+		categories[name] = qbitorrent.TorrentsCategory{Name: ptr(name), SavePath: ptr(savePath)}
+	}
+
+	return categories
+}
+
+func (s *Server) listCategories(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, s.categoryList())
+}
+
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("category")
+	if name == "" {
+		http.Error(w, "Category cannot be empty", http.StatusBadRequest)
+		return
+	}
+	if _, ok := s.categories[name]; ok || !validPath(name) {
+		http.Error(w, "Unable to create category", http.StatusConflict)
+		return
+	}
+
+	s.categories[name] = r.Form.Get("savePath")
+}
+
+func (s *Server) editCategory(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("category")
+	if _, ok := s.categories[name]; !ok {
+		http.Error(w, "Unable to edit category", http.StatusConflict)
+		return
+	}
+
+	s.categories[name] = r.Form.Get("savePath")
+}
+
+func (s *Server) removeCategories(w http.ResponseWriter, r *http.Request) {
+	for _, name := range splitList(r.Form.Get("categories"), "\n") {
+		delete(s.categories, name)
+		for _, t := range s.torrents {
+			if t.Category == name {
+				t.Category = ""
+			}
+		}
+	}
+}
+
+// setCategory moves torrents in automatic mode to the category save path.
+func (s *Server) setCategory(w http.ResponseWriter, r *http.Request) {
+	category := r.Form.Get("category")
+	if _, ok := s.categories[category]; category != "" && !ok {
+		http.Error(w, "Incorrect category name", http.StatusConflict)
+		return
+	}
+
+	for _, t := range s.torrentsByForm(r) {
+		t.Category = category
+		if t.AutoTMM {
+			s.startMove(t, s.categorySavePath(category))
+		}
+	}
+}
+
+func (s *Server) setAutoManagement(w http.ResponseWriter, r *http.Request) {
+	enable := r.Form.Get("enable") == "true"
+	for _, t := range s.torrentsByForm(r) {
+		t.AutoTMM = enable
+		if enable {
+			s.startMove(t, s.categorySavePath(t.Category))
+		}
+	}
+}
+
+// setLocation disables automatic mode like qbitorrent does.
+func (s *Server) setLocation(w http.ResponseWriter, r *http.Request) {
+	location := r.Form.Get("location")
+	if location == "" {
+		http.Error(w, "Save path cannot be empty", http.StatusBadRequest)
+		return
+	}
+
+	for _, t := range s.torrentsByForm(r) {
+		t.AutoTMM = false
+		s.startMove(t, location)
+	}
+}
+
+// startMove puts the torrent into moving state for MoveDelay, files are
+// moved and the save path changes when it finishes.
+func (s *Server) startMove(t *Torrent, location string) {
+	if location == t.SavePath && t.moveTo == "" {
+		return
+	}
+
+	if t.moveTo == "" {
+		t.movedState = t.State
+	}
+	t.moveTo = location
+	t.movedAt = time.Now().Add(s.MoveDelay)
+	t.State = qbitorrent.TorrentInfoStateMoving
+	s.update(time.Now())
+}
+
+func (s *Server) setShareLimits(w http.ResponseWriter, r *http.Request) {
+	ratio, err := strconv.ParseFloat(r.Form.Get("ratioLimit"), 32)
+	if err != nil {
+		http.Error(w, "ratioLimit is not valid", http.StatusBadRequest)
+		return
+	}
+	seedingTime, err := strconv.ParseFloat(r.Form.Get("seedingTimeLimit"), 64)
+	if err != nil {
+		http.Error(w, "seedingTimeLimit is not valid", http.StatusBadRequest)
+		return
+	}
+
+	inactiveTime := float64(-2)
+	if s.apiVersion().AtLeast(versionInactiveSeeding) {
+		inactiveTime, err = strconv.ParseFloat(r.Form.Get("inactiveSeedingTimeLimit"), 64)
+		if err != nil {
+			http.Error(w, "inactiveSeedingTimeLimit is not valid", http.StatusBadRequest)
+			return
+		}
+	}
+
+	for _, t := range s.torrentsByForm(r) {
+		t.RatioLimit = float32(ratio)
+		t.SeedingTimeLimit = int64(seedingTime)
+		t.InactiveSeedingTimeLimit = int64(inactiveTime)
+	}
+}
+
+func (s *Server) stop(w http.ResponseWriter, r *http.Request) {
+	for _, t := range s.torrentsByForm(r) {
+		t.State = qbitorrent.TorrentInfoStateStoppedDL
+		if t.Progress >= 1 {
+			t.State = qbitorrent.TorrentInfoStateStoppedUP
+		}
+	}
+}
+
+func (s *Server) start(w http.ResponseWriter, r *http.Request) {
+	for _, t := range s.torrentsByForm(r) {
+		switch {
+		case t.Files == nil:
+			t.State = qbitorrent.TorrentInfoStateMetaDL
+		case t.Progress >= 1:
+			t.State = qbitorrent.TorrentInfoStateUploading
+		default:
+			t.State = qbitorrent.TorrentInfoStateDownloading
+		}
+	}
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, slices.Sorted(maps.Keys(s.tags)))
+}
+
+func (s *Server) createTags(w http.ResponseWriter, r *http.Request) {
+	for _, tag := range formTags(r) {
+		s.tags[tag] = true
+	}
+}
+
+func (s *Server) deleteTags(w http.ResponseWriter, r *http.Request) {
+	for _, tag := range formTags(r) {
+		delete(s.tags, tag)
+		for _, t := range s.torrents {
+			t.Tags = slices.DeleteFunc(t.Tags, func(other string) bool { return other == tag })
+		}
+	}
+}
+
+func (s *Server) addTags(w http.ResponseWriter, r *http.Request) {
+	tags := formTags(r)
+	for _, t := range s.torrentsByForm(r) {
+		for _, tag := range tags {
+			if !slices.Contains(t.Tags, tag) {
+				t.Tags = append(t.Tags, tag)
+			}
+		}
+	}
+
+	for _, tag := range tags {
+		s.tags[tag] = true
+	}
+}
+
+func (s *Server) removeTags(w http.ResponseWriter, r *http.Request) {
+	tags := formTags(r)
+	for _, t := range s.torrentsByForm(r) {
+		t.Tags = slices.DeleteFunc(t.Tags, func(tag string) bool { return slices.Contains(tags, tag) })
+	}
+}
+
+func formTags(r *http.Request) []string {
+	var tags []string
+	for _, tag := range splitList(r.Form.Get("tags"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags
+}
+
+// writeFiles creates empty files of the torrent, skipped files included.
+func (s *Server) writeFiles(t *Torrent) {
+	if !s.WriteFiles || t.SavePath == "" {
+		return
+	}
+
+	for _, file := range t.Files {
+		name := diskPath(t.SavePath, file.Name)
+		os.MkdirAll(filepath.Dir(name), 0o755)
+		os.WriteFile(name, nil, 0o644)
+	}
+}
+
+// renameOnDisk renames a file or a folder of the torrent and removes
+// folders left empty.
+func (s *Server) renameOnDisk(t *Torrent, oldPath, newPath string) error {
+	if !s.WriteFiles || t.Files == nil {
+		return nil
+	}
+
+	newName := diskPath(t.SavePath, newPath)
+	err := os.MkdirAll(filepath.Dir(newName), 0o755)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(diskPath(t.SavePath, oldPath), newName)
+	if err != nil {
+		return err
+	}
+
+	removeEmptyParents(t.SavePath, diskPath(t.SavePath, oldPath))
+
+	return nil
+}
+
+func (s *Server) moveFiles(t *Torrent, location string) {
+	if !s.WriteFiles {
+		return
+	}
+
+	for _, file := range t.Files {
+		oldName, newName := diskPath(t.SavePath, file.Name), diskPath(location, file.Name)
+		os.MkdirAll(filepath.Dir(newName), 0o755)
+		os.Rename(oldName, newName)
+		removeEmptyParents(t.SavePath, oldName)
+	}
+}
+
+func (s *Server) removeFiles(t *Torrent) {
+	if !s.WriteFiles {
+		return
+	}
+
+	for _, file := range t.Files {
+		name := diskPath(t.SavePath, file.Name)
+		os.Remove(name)
+		removeEmptyParents(t.SavePath, name)
+	}
+}
+
+func diskPath(savePath, name string) string {
+	return filepath.Join(savePath, filepath.FromSlash(name))
+}
+
+// removeEmptyParents removes empty folders from the parent of name up to
+// root.
+func removeEmptyParents(root, name string) {
+	root = filepath.Clean(root)
+	for dir := filepath.Dir(name); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+		if os.Remove(dir) != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package qbitorrent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/shadream/kftm/qbitorrent/api"
+)
+
+// RssRule is an auto-downloading rule. Since qbitorrent 4.6 the category,
+// tags and save path of added torrents are set in torrentParams, which the
+// spec misses. Older versions ignore it and use assignedCategory.
+type RssRule struct {
+	RssRuleDef
+	TorrentParams *RssTorrentParams `json:"torrentParams,omitempty"`
+}
+
+// RssTorrentParams are parameters of torrents added by a rule.
+type RssTorrentParams struct {
+	Category string   `json:"category,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	SavePath string   `json:"save_path,omitempty"`
+}
+
+// RssFeed is a feed with its path in the tree of rss folders, e.g.
+// "Trackers\Movies".
+type RssFeed struct {
+	Path     string       `json:"-"`
+	Uid      string       `json:"uid"`
+	Url      string       `json:"url"`
+	Title    string       `json:"title"`
+	Articles []RssArticle `json:"articles"`
+}
+
+type RssArticle struct {
+	Id          string `json:"id"`
+	Title       string `json:"title"`
+	Date        string `json:"date"`
+	Description string `json:"description"`
+	Link        string `json:"link"`
+	TorrentUrl  string `json:"torrentURL"`
+	IsRead      bool   `json:"isRead"`
+}
+
+// SetRssRule creates the rule or replaces the rule with the same name.
+func (c *Client) SetRssRule(name string, rule RssRule) error {
+	return c.SetRssRuleContext(context.Background(), name, rule)
+}
+
+func (c *Client) SetRssRuleContext(ctx context.Context, name string, rule RssRule) error {
+	ruleDef, err := json.Marshal(rule)
+	if err != nil {
+		return fmt.Errorf("marshal rule: %w", err)
+	}
+
+	_, err = c.api.RssSetRulePostWithFormdataBodyWithResponse(ctx, api.RssSetRulePostFormdataRequestBody{
+		RuleName: name,
+		RuleDef:  string(ruleDef),
+	})
+
+	return err
+}
+
+func (c *Client) RemoveRssRule(name string) error {
+	return c.RemoveRssRuleContext(context.Background(), name)
+}
+
+func (c *Client) RemoveRssRuleContext(ctx context.Context, name string) error {
+	_, err := c.api.RssRemoveRulePostWithFormdataBodyWithResponse(ctx, api.RssRemoveRulePostFormdataRequestBody{RuleName: name})
+
+	return err
+}
+
+// GetRssRules returns rules by their names.
+func (c *Client) GetRssRules() (map[string]RssRuleDef, error) {
+	return c.GetRssRulesContext(context.Background())
+}
+
+func (c *Client) GetRssRulesContext(ctx context.Context) (map[string]RssRuleDef, error) {
+	resp, err := c.api.RssRulesGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+// GetRssMatchingArticles returns titles of articles matching the rule by
+// feed names.
+func (c *Client) GetRssMatchingArticles(ruleName string) (map[string][]string, error) {
+	return c.GetRssMatchingArticlesContext(context.Background(), ruleName)
+}
+
+func (c *Client) GetRssMatchingArticlesContext(ctx context.Context, ruleName string) (map[string][]string, error) {
+	resp, err := c.api.RssMatchingArticlesPostWithFormdataBodyWithResponse(ctx, api.RssMatchingArticlesPostFormdataRequestBody{
+		RuleName: ruleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+// GetRssFeeds returns all feeds from the tree of rss folders, articles are
+// filled when withData is set.
+func (c *Client) GetRssFeeds(withData bool) ([]RssFeed, error) {
+	return c.GetRssFeedsContext(context.Background(), withData)
+}
+
+func (c *Client) GetRssFeedsContext(ctx context.Context, withData bool) ([]RssFeed, error) {
+	resp, err := c.api.RssItemsPostWithFormdataBodyWithResponse(ctx, api.RssItemsPostFormdataRequestBody{WithData: &withData})
+	if err != nil {
+		return nil, err
+	}
+
+	var tree map[string]json.RawMessage
+	err = json.Unmarshal(resp.Body, &tree)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal rss items: %w", err)
+	}
+
+	return collectFeeds(tree, "")
+}
+
+// collectFeeds walks rss folders, a folder maps names to items while a feed
+// has an url.
+func collectFeeds(tree map[string]json.RawMessage, path string) ([]RssFeed, error) {
+	var feeds []RssFeed
+	for _, name := range slices.Sorted(maps.Keys(tree)) {
+		itemPath := name
+		if path != "" {
+			itemPath = path + `\` + name
+		}
+
+		var feed RssFeed
+		err := json.Unmarshal(tree[name], &feed)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal rss item %s: %w", itemPath, err)
+		}
+
+		if feed.Url != "" {
+			feed.Path = itemPath
+			feeds = append(feeds, feed)
+			continue
+		}
+
+		var folder map[string]json.RawMessage
+		err = json.Unmarshal(tree[name], &folder)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal rss folder %s: %w", itemPath, err)
+		}
+
+		folderFeeds, err := collectFeeds(folder, itemPath)
+		if err != nil {
+			return nil, err
+		}
+		feeds = append(feeds, folderFeeds...)
+	}
+
+	return feeds, nil
+}
@@ -0,0 +1,99 @@
+package qbitorrent
+
+import (
+	"context"
+
+	"github.com/shadream/kftm/qbitorrent/api"
+)
+
+// Search runs search plugins installed in qbitorrent. A search job is
+// started, its results are read while it runs, and the job is deleted when
+// it is not needed anymore.
+
+// StartSearch starts a search job with all enabled plugins and returns its
+// id. qbitorrent answers ErrConflict when too many searches are running.
+func (c *Client) StartSearch(pattern string) (int, error) {
+	return c.StartSearchContext(context.Background(), pattern)
+}
+
+func (c *Client) StartSearchContext(ctx context.Context, pattern string) (int, error) {
+	resp, err := c.api.SearchStartPostWithFormdataBodyWithResponse(ctx, api.SearchStartPostFormdataRequestBody{
+		Pattern:  pattern,
+		Plugins:  "enabled",
+		Category: "all",
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Id == nil {
+		return 0, &ResponseError{
+			StatusCode: resp.StatusCode(),
+			Endpoint:   "search/start",
+			Body:       "no search id",
+			Err:        ErrBadResponse,
+		}
+	}
+
+	return int(*resp.JSON200.Id), nil
+}
+
+// GetSearchResults returns results of the job starting from offset, status
+// of the job tells whether more results may come.
+func (c *Client) GetSearchResults(id, offset int) (*SearchResults, error) {
+	return c.GetSearchResultsContext(context.Background(), id, offset)
+}
+
+func (c *Client) GetSearchResultsContext(ctx context.Context, id, offset int) (*SearchResults, error) {
+	resp, err := c.api.SearchResultsPostWithFormdataBodyWithResponse(ctx, api.SearchResultsPostFormdataRequestBody{
+		Id:     &id,
+		Offset: &offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return &SearchResults{}, nil
+	}
+
+	return resp.JSON200, nil
+}
+
+func (c *Client) StopSearch(id int) error {
+	return c.StopSearchContext(context.Background(), id)
+}
+
+func (c *Client) StopSearchContext(ctx context.Context, id int) error {
+	_, err := c.api.SearchStopPostWithFormdataBodyWithResponse(ctx, api.SearchStopPostFormdataRequestBody{Id: id})
+
+	return err
+}
+
+// DeleteSearch stops the job if it runs and forgets its results.
+func (c *Client) DeleteSearch(id int) error {
+	return c.DeleteSearchContext(context.Background(), id)
+}
+
+func (c *Client) DeleteSearchContext(ctx context.Context, id int) error {
+	_, err := c.api.SearchDeletePostWithFormdataBodyWithResponse(ctx, api.SearchDeletePostFormdataRequestBody{Id: id})
+
+	return err
+}
+
+func (c *Client) GetSearchPlugins() ([]SearchPlugin, error) {
+	return c.GetSearchPluginsContext(context.Background())
+}
+
+func (c *Client) GetSearchPluginsContext(ctx context.Context) ([]SearchPlugin, error) {
+	resp, err := c.api.SearchPluginsGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
@@ -0,0 +1,218 @@
+package qbitorrent
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shadream/kftm/qbitorrent/api"
+)
+
+// Torrent lifecycle: start and stop, checks, location, naming, category,
+// tags and share limits.
+
+// PauseTorrents stops torrents, with /torrents/stop since WebAPI 2.11.0.
+func (c *Client) PauseTorrents(hashes []string) error {
+	return c.PauseTorrentsContext(context.Background(), hashes)
+}
+
+func (c *Client) PauseTorrentsContext(ctx context.Context, hashes []string) error {
+	stopStart, err := c.stopStart(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := api.Hashes{Hashes: joinHashes(hashes)}
+	if stopStart {
+		_, err = c.api.TorrentsStopPostWithFormdataBodyWithResponse(ctx, body)
+	} else {
+		_, err = c.api.TorrentsPausePostWithFormdataBodyWithResponse(ctx, body)
+	}
+
+	return err
+}
+
+// ResumeTorrents starts torrents, with /torrents/start since WebAPI 2.11.0.
+func (c *Client) ResumeTorrents(hashes []string) error {
+	return c.ResumeTorrentsContext(context.Background(), hashes)
+}
+
+func (c *Client) ResumeTorrentsContext(ctx context.Context, hashes []string) error {
+	stopStart, err := c.stopStart(ctx)
+	if err != nil {
+		return err
+	}
+
+	body := api.Hashes{Hashes: joinHashes(hashes)}
+	if stopStart {
+		_, err = c.api.TorrentsStartPostWithFormdataBodyWithResponse(ctx, body)
+	} else {
+		_, err = c.api.TorrentsResumePostWithFormdataBodyWithResponse(ctx, body)
+	}
+
+	return err
+}
+
+func (c *Client) RecheckTorrents(hashes []string) error {
+	return c.RecheckTorrentsContext(context.Background(), hashes)
+}
+
+func (c *Client) RecheckTorrentsContext(ctx context.Context, hashes []string) error {
+	_, err := c.api.TorrentsRecheckPostWithFormdataBodyWithResponse(ctx, api.Hashes{Hashes: joinHashes(hashes)})
+
+	return err
+}
+
+func (c *Client) ReannounceTorrents(hashes []string) error {
+	return c.ReannounceTorrentsContext(context.Background(), hashes)
+}
+
+func (c *Client) ReannounceTorrentsContext(ctx context.Context, hashes []string) error {
+	_, err := c.api.TorrentsReannouncePostWithFormdataBodyWithResponse(ctx, api.Hashes{Hashes: joinHashes(hashes)})
+
+	return err
+}
+
+// SetLocation moves torrent data. qbitorrent moves files in background, the
+// torrent is in "moving" state until it finishes.
+func (c *Client) SetLocation(data TorrentsSetLocationPostFormdataBody) error {
+	return c.SetLocationContext(context.Background(), data)
+}
+
+func (c *Client) SetLocationContext(ctx context.Context, data TorrentsSetLocationPostFormdataBody) error {
+	_, err := c.api.TorrentsSetLocationPostWithFormdataBodyWithResponse(ctx, api.TorrentsSetLocationPostFormdataRequestBody(data))
+
+	return err
+}
+
+// RenameTorrent changes the name qbitorrent shows for the torrent, files are
+// not renamed.
+func (c *Client) RenameTorrent(data TorrentsRenamePostFormdataBody) error {
+	return c.RenameTorrentContext(context.Background(), data)
+}
+
+func (c *Client) RenameTorrentContext(ctx context.Context, data TorrentsRenamePostFormdataBody) error {
+	_, err := c.api.TorrentsRenamePostWithFormdataBodyWithResponse(ctx, api.TorrentsRenamePostFormdataRequestBody(data))
+
+	return err
+}
+
+// SetCategory assigns the category, empty category removes it. With
+// automatic torrent management the torrent moves to the category save path.
+func (c *Client) SetCategory(data TorrentsSetCategoryPostFormdataBody) error {
+	return c.SetCategoryContext(context.Background(), data)
+}
+
+func (c *Client) SetCategoryContext(ctx context.Context, data TorrentsSetCategoryPostFormdataBody) error {
+	_, err := c.api.TorrentsSetCategoryPostWithFormdataBodyWithResponse(ctx, api.TorrentsSetCategoryPostFormdataRequestBody(data))
+
+	return err
+}
+
+func (c *Client) SetAutoManagement(data TorrentsSetAutoManagementPostFormdataBody) error {
+	return c.SetAutoManagementContext(context.Background(), data)
+}
+
+func (c *Client) SetAutoManagementContext(ctx context.Context, data TorrentsSetAutoManagementPostFormdataBody) error {
+	_, err := c.api.TorrentsSetAutoManagementPostWithFormdataBodyWithResponse(ctx, api.TorrentsSetAutoManagementPostFormdataRequestBody(data))
+
+	return err
+}
+
+// SetShareLimits sets ratio and seeding time (minutes) limits, -2 means the
+// global limit and -1 means no limit. Inactive seeding time limit defaults to
+// the global one on versions that require it.
+func (c *Client) SetShareLimits(data TorrentsSetShareLimitsPostFormdataBody) error {
+	return c.SetShareLimitsContext(context.Background(), data)
+}
+
+func (c *Client) SetShareLimitsContext(ctx context.Context, data TorrentsSetShareLimitsPostFormdataBody) error {
+	version, err := c.APIVersionContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if version.AtLeast(versionInactiveSeeding) && data.InactiveSeedingTimeLimit == nil {
+		global := -2
+		data.InactiveSeedingTimeLimit = &global
+	}
+
+	_, err = c.api.TorrentsSetShareLimitsPostWithFormdataBodyWithResponse(ctx, api.TorrentsSetShareLimitsPostFormdataRequestBody(data))
+
+	return err
+}
+
+func (c *Client) SetForceStart(data SetTorrentsValue) error {
+	return c.SetForceStartContext(context.Background(), data)
+}
+
+func (c *Client) SetForceStartContext(ctx context.Context, data SetTorrentsValue) error {
+	_, err := c.api.TorrentsSetForceStartPostWithFormdataBodyWithResponse(ctx, data)
+
+	return err
+}
+
+func (c *Client) ToggleSequentialDownload(hashes []string) error {
+	return c.ToggleSequentialDownloadContext(context.Background(), hashes)
+}
+
+func (c *Client) ToggleSequentialDownloadContext(ctx context.Context, hashes []string) error {
+	_, err := c.api.TorrentsToggleSequentialDownloadPostWithFormdataBodyWithResponse(ctx, api.Hashes{Hashes: joinHashes(hashes)})
+
+	return err
+}
+
+func (c *Client) GetTags() ([]string, error) {
+	return c.GetTagsContext(context.Background())
+}
+
+func (c *Client) GetTagsContext(ctx context.Context) ([]string, error) {
+	resp, err := c.api.TorrentsTagsGetWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.JSON200 == nil {
+		return nil, nil
+	}
+
+	return *resp.JSON200, nil
+}
+
+func (c *Client) CreateTags(tags []string) error {
+	return c.CreateTagsContext(context.Background(), tags)
+}
+
+func (c *Client) CreateTagsContext(ctx context.Context, tags []string) error {
+	_, err := c.api.TorrentsCreateTagsPostWithFormdataBodyWithResponse(ctx, api.TorrentsCreateTagsPostFormdataRequestBody{
+		Tags: joinList(tags),
+	})
+
+	return err
+}
+
+// DeleteTags removes tags from qbitorrent and from all torrents.
+func (c *Client) DeleteTags(tags []string) error {
+	return c.DeleteTagsContext(context.Background(), tags)
+}
+
+func (c *Client) DeleteTagsContext(ctx context.Context, tags []string) error {
+	_, err := c.api.TorrentsDeleteTagsPostWithFormdataBodyWithResponse(ctx, api.TorrentsDeleteTagsPostFormdataRequestBody{
+		Tags: joinList(tags),
+	})
+
+	return err
+}
+
+// TagList splits tags field of TorrentInfo.
+func TagList(torrent TorrentInfo) []string {
+	if torrent.Tags == nil || *torrent.Tags == "" {
+		return nil
+	}
+
+	tags := strings.Split(*torrent.Tags, ",")
+	for i := range tags {
+		tags[i] = strings.TrimSpace(tags[i])
+	}
+
+	return tags
+}
@@ -0,0 +1,109 @@
+package qbitorrent
+
+import "github.com/shadream/kftm/qbitorrent/api"
+
+// Types of the generated client used by the facade methods. They are aliases,
+// so values are passed to the generated client as is.
+
+type (
+	TorrentInfo      = api.TorrentInfo
+	TorrentInfoState = api.TorrentInfoState
+	TorrentsFiles    = api.TorrentsFiles
+	TorrentsCategory = api.TorrentsCategory
+
+	TorrentsFilesPriority = api.TorrentsFilesPriority
+
+	AddTorrentsURLs  = api.AddTorrentsURLs
+	AddTorrentsFiles = api.AddTorrentsFiles
+
+	AddTorrentsURLsPaused      = api.AddTorrentsURLsPaused
+	AddTorrentsURLsRootFolder  = api.AddTorrentsURLsRootFolder
+	AddTorrentsURLsStopped     = api.AddTorrentsURLsStopped
+	AddTorrentsFilesPaused     = api.AddTorrentsFilesPaused
+	AddTorrentsFilesRootFolder = api.AddTorrentsFilesRootFolder
+	AddTorrentsFilesStopped    = api.AddTorrentsFilesStopped
+
+	RenameTorrentFiles = api.RenameTorrentFiles
+	SetTorrentsValue   = api.SetTorrentsValue
+
+	TorrentsInfoPostFormdataBody              = api.TorrentsInfoPostFormdataBody
+	TorrentsInfoPostFormdataBodyFilter        = api.TorrentsInfoPostFormdataBodyFilter
+	TorrentsRenamePostFormdataBody            = api.TorrentsRenamePostFormdataBody
+	TorrentsSetAutoManagementPostFormdataBody = api.TorrentsSetAutoManagementPostFormdataBody
+	TorrentsSetCategoryPostFormdataBody       = api.TorrentsSetCategoryPostFormdataBody
+	TorrentsSetLocationPostFormdataBody       = api.TorrentsSetLocationPostFormdataBody
+	TorrentsSetShareLimitsPostFormdataBody    = api.TorrentsSetShareLimitsPostFormdataBody
+
+	RssRuleDef = api.RssRuleDef
+
+	SearchPlugin        = api.SearchPlugin
+	SearchResult        = api.SearchResult
+	SearchResults       = api.SearchResults
+	SearchResultsStatus = api.SearchResultsStatus
+)
+
+const (
+	TorrentInfoStateAllocating         = api.TorrentInfoStateAllocating
+	TorrentInfoStateCheckingDL         = api.TorrentInfoStateCheckingDL
+	TorrentInfoStateCheckingResumeData = api.TorrentInfoStateCheckingResumeData
+	TorrentInfoStateCheckingUP         = api.TorrentInfoStateCheckingUP
+	TorrentInfoStateDownloading        = api.TorrentInfoStateDownloading
+	TorrentInfoStateError              = api.TorrentInfoStateError
+	TorrentInfoStateForcedDL           = api.TorrentInfoStateForcedDL
+	TorrentInfoStateForcedUP           = api.TorrentInfoStateForcedUP
+	TorrentInfoStateMetaDL             = api.TorrentInfoStateMetaDL
+	TorrentInfoStateMissingFiles       = api.TorrentInfoStateMissingFiles
+	TorrentInfoStateMoving             = api.TorrentInfoStateMoving
+	TorrentInfoStatePausedDL           = api.TorrentInfoStatePausedDL
+	TorrentInfoStatePausedUP           = api.TorrentInfoStatePausedUP
+	TorrentInfoStateQueuedDL           = api.TorrentInfoStateQueuedDL
+	TorrentInfoStateQueuedUP           = api.TorrentInfoStateQueuedUP
+	TorrentInfoStateStalledDL          = api.TorrentInfoStateStalledDL
+	TorrentInfoStateStalledUP          = api.TorrentInfoStateStalledUP
+	TorrentInfoStateStoppedDL          = api.TorrentInfoStateStoppedDL
+	TorrentInfoStateStoppedUP          = api.TorrentInfoStateStoppedUP
+	TorrentInfoStateUnknown            = api.TorrentInfoStateUnknown
+	TorrentInfoStateUploading          = api.TorrentInfoStateUploading
+)
+
+const (
+	TorrentsFilesPriorityN0 = api.TorrentsFilesPriorityN0
+	TorrentsFilesPriorityN1 = api.TorrentsFilesPriorityN1
+	TorrentsFilesPriorityN6 = api.TorrentsFilesPriorityN6
+	TorrentsFilesPriorityN7 = api.TorrentsFilesPriorityN7
+)
+
+const (
+	TorrentsInfoPostFormdataBodyFilterActive             = api.TorrentsInfoPostFormdataBodyFilterActive
+	TorrentsInfoPostFormdataBodyFilterAll                = api.TorrentsInfoPostFormdataBodyFilterAll
+	TorrentsInfoPostFormdataBodyFilterCompleted          = api.TorrentsInfoPostFormdataBodyFilterCompleted
+	TorrentsInfoPostFormdataBodyFilterDownloading        = api.TorrentsInfoPostFormdataBodyFilterDownloading
+	TorrentsInfoPostFormdataBodyFilterErrored            = api.TorrentsInfoPostFormdataBodyFilterErrored
+	TorrentsInfoPostFormdataBodyFilterInactive           = api.TorrentsInfoPostFormdataBodyFilterInactive
+	TorrentsInfoPostFormdataBodyFilterPaused             = api.TorrentsInfoPostFormdataBodyFilterPaused
+	TorrentsInfoPostFormdataBodyFilterResumed            = api.TorrentsInfoPostFormdataBodyFilterResumed
+	TorrentsInfoPostFormdataBodyFilterRunning            = api.TorrentsInfoPostFormdataBodyFilterRunning
+	TorrentsInfoPostFormdataBodyFilterSeeding            = api.TorrentsInfoPostFormdataBodyFilterSeeding
+	TorrentsInfoPostFormdataBodyFilterStalled            = api.TorrentsInfoPostFormdataBodyFilterStalled
+	TorrentsInfoPostFormdataBodyFilterStalledDownloading = api.TorrentsInfoPostFormdataBodyFilterStalledDownloading
+	TorrentsInfoPostFormdataBodyFilterStalledUploading   = api.TorrentsInfoPostFormdataBodyFilterStalledUploading
+	TorrentsInfoPostFormdataBodyFilterStopped            = api.TorrentsInfoPostFormdataBodyFilterStopped
+)
+
+const (
+	AddTorrentsURLsPausedFalse      = api.AddTorrentsURLsPausedFalse
+	AddTorrentsURLsPausedTrue       = api.AddTorrentsURLsPausedTrue
+	AddTorrentsURLsRootFolderFalse  = api.AddTorrentsURLsRootFolderFalse
+	AddTorrentsURLsRootFolderTrue   = api.AddTorrentsURLsRootFolderTrue
+	AddTorrentsURLsRootFolderUnset  = api.AddTorrentsURLsRootFolderUnset
+	AddTorrentsFilesPausedFalse     = api.AddTorrentsFilesPausedFalse
+	AddTorrentsFilesPausedTrue      = api.AddTorrentsFilesPausedTrue
+	AddTorrentsFilesRootFolderFalse = api.AddTorrentsFilesRootFolderFalse
+	AddTorrentsFilesRootFolderTrue  = api.AddTorrentsFilesRootFolderTrue
+	AddTorrentsFilesRootFolderUnset = api.AddTorrentsFilesRootFolderUnset
+)
+
+const (
+	SearchResultsStatusRunning = api.SearchResultsStatusRunning
+	SearchResultsStatusStopped = api.SearchResultsStatusStopped
+)
@@ -0,0 +1,137 @@
+package qbitorrent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// APIVersion is the WebAPI version, it differs from the qbitorrent version,
+// e.g. qbitorrent 5.0 has WebAPI 2.11.2.
+type APIVersion struct {
+	Major int
+	Minor int
+	Patch int
+}
+
+var (
+	// pause and resume are renamed to stop and start in endpoints, states
+	// and filters
+	versionStopStart = APIVersion{2, 11, 0}
+	// setShareLimits requires inactiveSeedingTimeLimit
+	versionInactiveSeeding = APIVersion{2, 9, 2}
+)
+
+// ParseAPIVersion parses versions like "2.8.3" or "2.11".
+func ParseAPIVersion(str string) (APIVersion, error) {
+	parts := strings.Split(strings.TrimSpace(str), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return APIVersion{}, fmt.Errorf("invalid api version %q", str)
+	}
+
+	var numbers [3]int
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return APIVersion{}, fmt.Errorf("invalid api version %q", str)
+		}
+		numbers[i] = number
+	}
+
+	return APIVersion{numbers[0], numbers[1], numbers[2]}, nil
+}
+
+func (v APIVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// AtLeast reports whether v is other or newer.
+func (v APIVersion) AtLeast(other APIVersion) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor > other.Minor
+	}
+
+	return v.Patch >= other.Patch
+}
+
+// APIVersion returns the WebAPI version of qbitorrent. It is requested once
+// per session, on login or on the first call.
+func (c *Client) APIVersion() (APIVersion, error) {
+	return c.APIVersionContext(context.Background())
+}
+
+func (c *Client) APIVersionContext(ctx context.Context) (APIVersion, error) {
+	c.mu.Lock()
+	version := c.version
+	c.mu.Unlock()
+
+	if version != nil {
+		return *version, nil
+	}
+
+	resp, err := c.api.AppWebapiVersionGetWithResponse(ctx)
+	if err != nil {
+		return APIVersion{}, err
+	}
+
+	parsed, err := ParseAPIVersion(string(resp.Body))
+	if err != nil {
+		return APIVersion{}, err
+	}
+
+	c.mu.Lock()
+	c.version = &parsed
+	c.mu.Unlock()
+
+	return parsed, nil
+}
+
+// stopStart reports whether qbitorrent uses stop and start instead of pause
+// and resume.
+func (c *Client) stopStart(ctx context.Context) (bool, error) {
+	version, err := c.APIVersionContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return version.AtLeast(versionStopStart), nil
+}
+
+// normalizeState converts states of older versions to the current names, so
+// callers check only stoppedDL and stoppedUP.
+func normalizeState(state *TorrentInfoState) {
+	if state == nil {
+		return
+	}
+
+	switch *state {
+	case TorrentInfoStatePausedDL:
+		*state = TorrentInfoStateStoppedDL
+	case TorrentInfoStatePausedUP:
+		*state = TorrentInfoStateStoppedUP
+	}
+}
+
+// versionFilter converts the torrent list filter to the name the version
+// understands.
+func versionFilter(filter TorrentsInfoPostFormdataBodyFilter, stopStart bool) TorrentsInfoPostFormdataBodyFilter {
+	renames := map[TorrentsInfoPostFormdataBodyFilter]TorrentsInfoPostFormdataBodyFilter{
+		TorrentsInfoPostFormdataBodyFilterPaused:  TorrentsInfoPostFormdataBodyFilterStopped,
+		TorrentsInfoPostFormdataBodyFilterResumed: TorrentsInfoPostFormdataBodyFilterRunning,
+	}
+
+	for old, current := range renames {
+		if stopStart && filter == old {
+			return current
+		}
+		if !stopStart && filter == current {
+			return old
+		}
+	}
+
+	return filter
+}
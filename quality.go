@@ -0,0 +1,222 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// QualityConfig holds named quality profiles used whenever kftm chooses
+// between releases: search results, rss items and files of a torrent.
+type QualityConfig struct {
+	// Name of the profile used when no other is asked for
+	Default  string                    `json:"default"`
+	Profiles map[string]QualityProfile `json:"profiles"`
+}
+
+// Profile returns the profile with the name or the default one when name is
+// empty. Without configured profiles every release is allowed.
+func (c QualityConfig) Profile(name string) (QualityProfile, error) {
+	if name == "" {
+		name = c.Default
+	}
+	if name == "" && len(c.Profiles) == 0 {
+		return QualityProfile{}, nil
+	}
+
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return QualityProfile{}, fmt.Errorf("unknown quality profile %q", name)
+	}
+
+	return profile, nil
+}
+
+// QualityProfile decides which releases are acceptable and how good they
+// are. Words are matched in release names case insensitively, "WEB-DL" and
+// "web dl" are the same.
+type QualityProfile struct {
+	// Allowed resolutions from the most wanted, e.g. ["1080p", "2160p"].
+	// All are allowed when empty, releases without resolution in the name
+	// are always allowed
+	Resolutions []string `json:"resolutions"`
+	// Releases must have all of the words
+	Required []string `json:"required"`
+	// Releases with any of the words are rejected, e.g. ["CAMRip", "TS"]
+	Forbidden []string `json:"forbidden"`
+	// Score added for each word, negative scores are allowed, e.g.
+	// {"Дубляж": 50, "HDR": 20}
+	Preferred map[string]int `json:"preferred"`
+	// Size limits in megabytes per minute of the movie, 0 is no limit.
+	// Not checked when kinopoisk does not know the length
+	MinSizePerMinute float64 `json:"min_size_per_minute"`
+	MaxSizePerMinute float64 `json:"max_size_per_minute"`
+	// Releases with fewer seeders are rejected
+	MinSeeders int `json:"min_seeders"`
+}
+
+// Release is anything the profile can score.
+type Release struct {
+	// Name of the release, or of the file without its extension
+	Name string
+	Size int64
+	// -1 when unknown, e.g. for files of a torrent
+	Seeders int
+}
+
+// Score of a release with the reasons it is made of, higher is better.
+type Score struct {
+	Total    int
+	Rejected bool
+	Reasons  []string
+}
+
+func (s *Score) add(points int, reason string) {
+	s.Total += points
+	s.Reasons = append(s.Reasons, fmt.Sprintf("%+d %s", points, reason))
+}
+
+func (s *Score) reject(reason string) {
+	s.Rejected = true
+	s.Reasons = append(s.Reasons, "rejected: "+reason)
+}
+
+// String explains the score, e.g. "320 (+300 1080p, +20 dub)" or
+// "rejected: 720p, CAMRip".
+func (s Score) String() string {
+	if s.Rejected {
+		var rejections []string
+		for _, reason := range s.Reasons {
+			if rejection, ok := strings.CutPrefix(reason, "rejected: "); ok {
+				rejections = append(rejections, rejection)
+			}
+		}
+		return "rejected: " + strings.Join(rejections, ", ")
+	}
+	if len(s.Reasons) == 0 {
+		return fmt.Sprint(s.Total)
+	}
+
+	return fmt.Sprintf("%d (%s)", s.Total, strings.Join(s.Reasons, ", "))
+}
+
+// Score rates the release for a movie of the given length in minutes, 0
+// length skips size limits.
+func (q QualityProfile) Score(release Release, minutes int64) Score {
+	var score Score
+	words := nameWords(release.Name)
+
+	// preferred resolution outweighs everything else
+	resolution := resolutionOf(release.Name)
+	index := slices.Index(q.Resolutions, resolution)
+	switch {
+	case resolution == "" || len(q.Resolutions) == 0:
+	case index == -1:
+		score.reject(resolution)
+	default:
+		score.add((len(q.Resolutions)-index)*100, resolution)
+	}
+
+	for _, word := range q.Required {
+		if !containsPhrase(words, word) {
+			score.reject("no " + word)
+		}
+	}
+
+	for _, word := range q.Forbidden {
+		if containsPhrase(words, word) {
+			score.reject(word)
+		}
+	}
+
+	// map order is random, reasons are sorted to read the same every time
+	for _, word := range slices.Sorted(maps.Keys(q.Preferred)) {
+		if containsPhrase(words, word) {
+			score.add(q.Preferred[word], word)
+		}
+	}
+
+	if minutes > 0 && release.Size > 0 {
+		perMinute := float64(release.Size) / (1 << 20) / float64(minutes)
+		if q.MinSizePerMinute > 0 && perMinute < q.MinSizePerMinute ||
+			q.MaxSizePerMinute > 0 && perMinute > q.MaxSizePerMinute {
+			score.reject(fmt.Sprintf("%.0f MB per minute", perMinute))
+		}
+	}
+
+	if release.Seeders >= 0 {
+		if release.Seeders < q.MinSeeders {
+			score.reject(fmt.Sprintf("%d seeders", release.Seeders))
+		}
+
+		// seeders make download faster, but a hundred is enough
+		if points := min(release.Seeders, 100) / 5; points > 0 {
+			score.add(points, "seeders")
+		}
+	}
+
+	return score
+}
+
+// compareScores orders better scores first, rejected ones last.
+func compareScores(a, b Score) int {
+	if a.Rejected != b.Rejected {
+		if a.Rejected {
+			return 1
+		}
+		return -1
+	}
+
+	return b.Total - a.Total
+}
+
+var resolutionRegex = regexp.MustCompile(`(?i)\b((?:2160|1080|720|576|480)[pi])\b|\b(4k|uhd)\b`)
+
+// resolutionOf finds resolution like "1080p" or "576i" in the release name,
+// 4k and uhd are 2160p. Interlaced resolutions keep the i, profiles list them
+// separately.
+func resolutionOf(name string) string {
+	match := resolutionRegex.FindStringSubmatch(name)
+	switch {
+	case match == nil:
+		return ""
+	case match[1] != "":
+		return strings.ToLower(match[1])
+	default:
+		return "2160p"
+	}
+}
+
+// withoutExtension cuts the extension of a media file from the name, so
+// words like "TS" are not found in it. Other extensions are left, release
+// names like "Movie.2020.WEB-DL" have dots too.
+func withoutExtension(name string) string {
+	ext := strings.ToLower(path.Ext(name))
+	for _, known := range [][]string{videoExtensions, subtitleExtensions, audioExtensions} {
+		if slices.Contains(known, ext) {
+			return strings.TrimSuffix(name, path.Ext(name))
+		}
+	}
+
+	return name
+}
+
+// containsPhrase reports whether words of the phrase follow each other in
+// words.
+func containsPhrase(words []string, phrase string) bool {
+	wanted := nameWords(phrase)
+	if len(wanted) == 0 {
+		return false
+	}
+
+	for i := 0; i+len(wanted) <= len(words); i++ {
+		if slices.Equal(words[i:i+len(wanted)], wanted) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestContainsPhrase(t *testing.T) {
+	tests := []struct {
+		name   string
+		phrase string
+		want   bool
+	}{
+		{name: "Movie.2020.1080p.WEB-DL", phrase: "WEB-DL", want: true},
+		{name: "Movie.2020.1080p.WEB.DL", phrase: "web dl", want: true},
+		{name: "Movie.2020.1080p.WEBRip", phrase: "WEB-DL", want: false},
+		{name: "Фильм (2020) Дубляж", phrase: "дубляж", want: true},
+		{name: "Movie.2020.HDTS", phrase: "TS", want: false},
+		{name: "Movie 2020 TS", phrase: "TS", want: true},
+		{name: "Movie.2020.DL.WEB", phrase: "WEB-DL", want: false},
+		{name: "Movie", phrase: "", want: false},
+		{name: "Movie", phrase: "...", want: false},
+	}
+
+	for _, test := range tests {
+		if got := containsPhrase(nameWords(test.name), test.phrase); got != test.want {
+			t.Errorf("containsPhrase(%q, %q) = %v, want %v", test.name, test.phrase, got, test.want)
+		}
+	}
+}
+
+func TestResolutionOf(t *testing.T) {
+	tests := map[string]string{
+		"Movie.2020.1080p.WEB-DL":   "1080p",
+		"Movie.2020.1080P.WEB-DL":   "1080p",
+		"Movie.2020.720p":           "720p",
+		"Movie.1990.DVDRip.576i":    "576i",
+		"Movie.1990.480i.DVB":       "480i",
+		"Movie.2020.4K.HDR":         "2160p",
+		"Movie.2020.UHD.BluRay":     "2160p",
+		"Movie.2020.BDRip":          "",
+		"Movie.10800p":              "",
+		"Movie.2020.1080p.2160p":    "1080p",
+		"Movie.2020.x264-1080pTeam": "",
+	}
+
+	for name, want := range tests {
+		if got := resolutionOf(name); got != want {
+			t.Errorf("resolutionOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	profile := QualityProfile{
+		Resolutions:      []string{"1080p", "2160p", "720p"},
+		Forbidden:        []string{"CAMRip", "TS"},
+		Preferred:        map[string]int{"Дубляж": 50, "HDR": 20, "Line": -30},
+		MinSizePerMinute: 10,
+		MaxSizePerMinute: 100,
+		MinSeeders:       2,
+	}
+	const gb = 1 << 30
+
+	tests := []struct {
+		name     string
+		profile  QualityProfile
+		release  Release
+		minutes  int64
+		total    int
+		rejected bool
+		reasons  []string
+	}{
+		{
+			name:    "preferred resolution and words",
+			profile: profile,
+			release: Release{Name: "Movie.2020.1080p.HDR.Дубляж", Size: 4 * gb, Seeders: 50},
+			minutes: 120,
+			total:   300 + 20 + 50 + 10,
+			reasons: []string{"+300 1080p", "+20 HDR", "+50 Дубляж", "+10 seeders"},
+		},
+		{
+			name:    "negative preferred word",
+			profile: profile,
+			release: Release{Name: "Movie.2020.720p.Line", Seeders: -1},
+			total:   100 - 30,
+			reasons: []string{"+100 720p", "-30 Line"},
+		},
+		{
+			name:    "seeders are capped",
+			profile: profile,
+			release: Release{Name: "Movie.2020.2160p", Seeders: 5000},
+			total:   200 + 20,
+			reasons: []string{"+200 2160p", "+20 seeders"},
+		},
+		{
+			name:     "not allowed resolution",
+			profile:  profile,
+			release:  Release{Name: "Movie.1990.576i", Seeders: -1},
+			rejected: true,
+			reasons:  []string{"rejected: 576i"},
+		},
+		{
+			name:     "forbidden word",
+			profile:  profile,
+			release:  Release{Name: "Movie 2020 TS 720p", Seeders: -1},
+			total:    100,
+			rejected: true,
+			reasons:  []string{"+100 720p", "rejected: TS"},
+		},
+		{
+			name:    "file without extension",
+			profile: profile,
+			release: Release{Name: withoutExtension("Movie/Movie.2020.1080p.ts"), Size: 4 * gb, Seeders: -1},
+			minutes: 120,
+			total:   300,
+			reasons: []string{"+300 1080p"},
+		},
+		{
+			name:     "forbidden word is matched in release names with dots",
+			profile:  profile,
+			release:  Release{Name: "Movie.2020.720p.TS", Seeders: -1},
+			total:    100,
+			rejected: true,
+			reasons:  []string{"+100 720p", "rejected: TS"},
+		},
+		{
+			name:     "too small",
+			profile:  profile,
+			release:  Release{Name: "Movie.2020.1080p", Size: 600 << 20, Seeders: -1},
+			minutes:  120,
+			total:    300,
+			rejected: true,
+			reasons:  []string{"+300 1080p", "rejected: 5 MB per minute"},
+		},
+		{
+			name:    "size without movie length",
+			profile: profile,
+			release: Release{Name: "Movie.2020.1080p", Size: 600 << 20, Seeders: -1},
+			total:   300,
+			reasons: []string{"+300 1080p"},
+		},
+		{
+			name:     "too few seeders",
+			profile:  profile,
+			release:  Release{Name: "Movie.2020.1080p", Seeders: 1},
+			total:    300,
+			rejected: true,
+			reasons:  []string{"+300 1080p", "rejected: 1 seeders"},
+		},
+		{
+			name:     "required word",
+			profile:  QualityProfile{Required: []string{"HDR", "Remux"}},
+			release:  Release{Name: "Movie.2020.2160p.HDR", Seeders: -1},
+			rejected: true,
+			reasons:  []string{"rejected: no Remux"},
+		},
+		{
+			name:    "empty profile allows everything",
+			release: Release{Name: "Movie.2020.CAMRip", Size: 1, Seeders: 0},
+			minutes: 120,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			score := test.profile.Score(test.release, test.minutes)
+
+			if score.Total != test.total || score.Rejected != test.rejected || !slices.Equal(score.Reasons, test.reasons) {
+				t.Errorf("got %d, rejected %v, %q\nwant %d, rejected %v, %q",
+					score.Total, score.Rejected, score.Reasons, test.total, test.rejected, test.reasons)
+			}
+		})
+	}
+}
+
+func TestWithoutExtension(t *testing.T) {
+	tests := map[string]string{
+		"Movie/Movie.2020.1080p.ts":  "Movie/Movie.2020.1080p",
+		"Movie.2020.1080p.MKV":       "Movie.2020.1080p",
+		"Movie/Subs/rus.srt":         "Movie/Subs/rus",
+		"Movie.2020.720p.WEB-DL":     "Movie.2020.720p.WEB-DL",
+		"Movie.2020.1080p":           "Movie.2020.1080p",
+		"Movie.2020.720p.TS.torrent": "Movie.2020.720p.TS.torrent",
+	}
+
+	for name, want := range tests {
+		if got := withoutExtension(name); got != want {
+			t.Errorf("withoutExtension(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestScoreString(t *testing.T) {
+	tests := []struct {
+		score Score
+		want  string
+	}{
+		{score: Score{}, want: "0"},
+		{score: Score{Total: 320, Reasons: []string{"+300 1080p", "+20 dub"}}, want: "320 (+300 1080p, +20 dub)"},
+		{
+			score: Score{Total: 300, Rejected: true, Reasons: []string{"+300 1080p", "rejected: TS", "rejected: 1 seeders"}},
+			want:  "rejected: TS, 1 seeders",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.score.String(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestCompareScores(t *testing.T) {
+	scores := []Score{
+		{Total: 100},
+		{Total: 500, Rejected: true},
+		{Total: 300},
+		{Total: -20},
+		{Total: 0, Rejected: true},
+		{Total: 300},
+	}
+
+	slices.SortStableFunc(scores, compareScores)
+
+	want := []Score{
+		{Total: 300},
+		{Total: 300},
+		{Total: 100},
+		{Total: -20},
+		{Total: 500, Rejected: true},
+		{Total: 0, Rejected: true},
+	}
+	for i := range want {
+		if scores[i].Total != want[i].Total || scores[i].Rejected != want[i].Rejected {
+			t.Fatalf("sorted %v, want %v", scores, want)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/shadream/kftm/kinopoisk"
+)
+
+// errNotProcessed is returned for folders neither an nfo with kinopoisk id
+// nor a job tells the movie of.
+var errNotProcessed = errors.New("not processed by kftm")
+
+// refresh updates metadata of already processed items. Items are found by
+// kinopoisk ids of nfo files or by jobs which processed the folders, in the
+// given folders or in every folder of the save paths.
+func refresh(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("refresh", flag.ExitOnError)
+	only := flags.String("only", "all", "comma separated fields to refresh: ratings, plot, cast, artwork, details or all")
+	force := flags.Bool("force", false, "ignore lockdata and lockedfields of nfo files")
+	flags.Parse(args)
+
+	fields, err := ParseNfoFields(*only)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config, err := readConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dirs := flags.Args()
+	scan := len(dirs) == 0
+	var scanned []string
+	if scan {
+		var roots []string
+		for _, category := range config.Qbitorrent.Categories() {
+			roots = append(roots, category.RealSavePath)
+			if category.Library != nil {
+				roots = append(roots, category.Library.RealSavePath)
+			}
+		}
+
+		for _, root := range roots {
+			if root == "" || slices.Contains(scanned, root) {
+				continue
+			}
+			scanned = append(scanned, root)
+
+			rootDirs, err := listDirs(root)
+			if err != nil {
+				log.Fatal(err)
+			}
+			dirs = append(dirs, rootDirs...)
+		}
+	}
+
+	kClient := createKinopoiskClient(config)
+
+	jobs, err := OpenJobStore(jobStorePath())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var refreshed, failed int
+	for _, dir := range dirs {
+		err = refreshDir(ctx, kClient, jobs, dir, fields, *force)
+		switch {
+		case ctx.Err() != nil:
+			log.Fatal("interrupted")
+		case scan && errors.Is(err, errNotProcessed):
+			// other folders of the save path
+		case err != nil:
+			log.Printf("%s: %v", dir, err)
+			failed++
+		default:
+			refreshed++
+		}
+	}
+
+	fmt.Printf("refreshed: %d, failed: %d\n", refreshed, failed)
+}
+
+// refreshDir updates the nfo of the movie folder with selected fields. The
+// nfo is written whole when the folder has none.
+func refreshDir(ctx context.Context, kClient *kinopoisk.Client, jobs *JobStore, dir string, fields NfoFields, force bool) error {
+	nfo := &MovieNfo{}
+	nfoPath, err := FindNfo(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		nfoPath = filepath.Join(dir, filepath.Base(dir)+".nfo")
+		fields = NfoAll
+	case err != nil:
+		return err
+	default:
+		nfo, err = ReadNfo(nfoPath)
+		if err != nil {
+			return err
+		}
+	}
+
+	filmId := nfo.KinopoiskID()
+	if filmId == -1 {
+		// movie folders are named after jobs
+		job, ok := jobs.ByName(filepath.Base(dir))
+		if !ok {
+			return fmt.Errorf("no kinopoisk id in nfo and no job: %w", errNotProcessed)
+		}
+		filmId = job.KinopoiskID
+	}
+
+	if !force {
+		fields &^= nfo.LockedFields()
+	}
+
+	if fields == 0 {
+		fmt.Printf("%s: locked, skipped\n", dir)
+		return nil
+	}
+
+	movie, err := kClient.GetByIdContext(ctx, filmId)
+	if err != nil {
+		return err
+	}
+
+	merged := MergeNfo(*nfo, KinopoiskDtoToNfo(*movie), fields)
+	err = WriteNfo(nfoPath, merged)
+	if err != nil {
+		return err
+	}
+
+	if fields&NfoArtwork != 0 {
+		err = downloadArtwork(ctx, *movie, dir)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("%s: updated\n", dir)
+
+	return nil
+}
+
+// downloadArtwork saves poster and backdrop of the movie to dir.
+func downloadArtwork(ctx context.Context, movie kinopoisk.Movie, dir string) error {
+	images := map[string]string{
+		"poster.jpg": movie.Poster.URL,
+		"fanart.jpg": movie.Backdrop.URL,
+	}
+
+	for name, url := range images {
+		if url == "" {
+			continue
+		}
+
+		err := downloadImage(ctx, url, filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func listDirs(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("read save path: %w", err)
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, filepath.Join(root, entry.Name()))
+		}
+	}
+
+	return dirs, nil
+}
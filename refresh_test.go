@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/kinopoisk/kinopoisktest"
+)
+
+// newRefreshTest returns a client of the fake kinopoisk and an empty job
+// store.
+func newRefreshTest(t *testing.T) (*kinopoisk.Client, *JobStore) {
+	t.Helper()
+
+	kClient := kinopoisk.NewClient(kinopoisktest.DefaultToken)
+	kClient.BaseUrl = newKinopoiskServer(t).URL
+
+	jobs, err := OpenJobStore(filepath.Join(t.TempDir(), "jobs.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return kClient, jobs
+}
+
+// movieDir creates the movie folder with the nfo, none when nfo is empty.
+func movieDir(t *testing.T, nfo []byte) string {
+	t.Helper()
+
+	dir := filepath.Join(t.TempDir(), testMovieName)
+	err := os.Mkdir(dir, 0o755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nfo != nil {
+		err = os.WriteFile(filepath.Join(dir, testMovieName+".nfo"), nfo, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestRefreshDirUpdatesSelectedFields(t *testing.T) {
+	kClient, jobs := newRefreshTest(t)
+	edited, _ := os.ReadFile(editedNfo)
+	dir := movieDir(t, edited)
+
+	err := refreshDir(context.Background(), kClient, jobs, dir, NfoRatings, false)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	nfo, err := ReadNfo(filepath.Join(dir, testMovieName+".nfo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value := nfo.Ratings.Rating[0].Value; nfo.Ratings.Rating[0].Name != "kinopoisk" || value == "9.1" {
+		t.Errorf("kinopoisk rating %q is not updated", value)
+	}
+	if nfo.Plot != "Сюжет, исправленный вручную." {
+		t.Errorf("plot %q is updated, only ratings are asked for", nfo.Plot)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "poster.jpg"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("artwork is downloaded: %v", err)
+	}
+}
+
+func TestRefreshDirFindsMovieByJob(t *testing.T) {
+	kClient, jobs := newRefreshTest(t)
+	dir := movieDir(t, nil)
+
+	err := jobs.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Stage: StageFinished})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = refreshDir(context.Background(), kClient, jobs, dir, NfoRatings, false)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	// the missing nfo is written whole
+	nfo, err := ReadNfo(filepath.Join(dir, testMovieName+".nfo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nfo.KinopoiskID() != 326 || nfo.Plot == "" {
+		t.Errorf("nfo of kinopoisk id %d with plot %q", nfo.KinopoiskID(), nfo.Plot)
+	}
+
+	_, err = os.Stat(filepath.Join(dir, "poster.jpg"))
+	if err != nil {
+		t.Errorf("poster is not downloaded: %v", err)
+	}
+}
+
+func TestRefreshDirSkipsOtherFolders(t *testing.T) {
+	kClient, jobs := newRefreshTest(t)
+
+	// unfinished jobs do not own folders yet
+	err := jobs.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Stage: StageRenamed})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, nfo := range map[string][]byte{
+		"no nfo":           nil,
+		"nfo without id":   []byte(`<movie><title>Домашнее видео</title></movie>`),
+		"nfo of other ids": []byte(`<movie><uniqueid type="imdb">tt0111161</uniqueid></movie>`),
+	} {
+		err = refreshDir(context.Background(), kClient, jobs, movieDir(t, nfo), NfoAll, false)
+		if !errors.Is(err, errNotProcessed) {
+			t.Errorf("%s: %v, want %v", name, err, errNotProcessed)
+		}
+	}
+}
+
+func TestRefreshDirRespectsLockdata(t *testing.T) {
+	kClient, jobs := newRefreshTest(t)
+	locked := []byte(`<movie><uniqueid type="kinopoisk">326</uniqueid><lockdata>true</lockdata></movie>`)
+	dir := movieDir(t, locked)
+
+	err := refreshDir(context.Background(), kClient, jobs, dir, NfoAll, false)
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+
+	got, _ := os.ReadFile(filepath.Join(dir, testMovieName+".nfo"))
+	if !bytes.Equal(got, locked) {
+		t.Errorf("locked nfo is rewritten: %s", got)
+	}
+
+	err = refreshDir(context.Background(), kClient, jobs, dir, NfoAll, true)
+	if err != nil {
+		t.Fatalf("forced refresh: %v", err)
+	}
+
+	nfo, _ := ReadNfo(filepath.Join(dir, testMovieName+".nfo"))
+	if nfo.Title != "Побег из Шоушенка" || !nfo.IsLocked() {
+		t.Errorf("forced refresh wrote title %q, lockdata %q", nfo.Title, nfo.Lockdata)
+	}
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+const defaultScheduleInterval = 6 * time.Hour
+
+// want adds a movie to the wanted list, lists or removes wanted movies.
+func want(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("want", flag.ExitOnError)
+	profileName := flags.String("profile", "", "quality profile to choose the release, the default one from config if empty")
+	list := flags.Bool("list", false, "list wanted movies")
+	remove := flags.Bool("remove", false, "remove the movie from the wanted list")
+	flags.Parse(args)
+
+	config, err := readConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	store, err := OpenWantedStore(wantedStorePath())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *list {
+		for _, item := range store.List() {
+			status := "wanted"
+			switch {
+			case item.Done:
+				status = "done"
+			case item.Hash != "":
+				status = "grabbed " + item.Hash
+			case !item.CheckedAt.IsZero():
+				status = "checked " + humanize.Time(item.CheckedAt)
+			}
+			fmt.Printf("%d\t%s\t%s\n", item.KinopoiskID, item.Name, status)
+		}
+		return
+	}
+
+	filmId := parseKinopoiskUrl(flags.Arg(0))
+	if flags.NArg() != 1 || filmId == -1 {
+		log.Fatal("usage: kftm want [-profile name] [-remove] <kinopoisk url or id> | -list")
+	}
+
+	if *remove {
+		err = store.Delete(filmId)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	// fail early on a typo in the profile name
+	_, err = config.Quality.Profile(*profileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	movie, err := createKinopoiskClient(config).GetByIdContext(ctx, filmId)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = store.Save(Wanted{
+		KinopoiskID: filmId,
+		Name:        movieName(*movie),
+		Profile:     *profileName,
+		AddedAt:     time.Now(),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("%s is wanted, run \"kftm schedule\" to look for it\n", movieName(*movie))
+}
+
+// schedule looks for releases of wanted movies in rss feeds and with search
+// plugins every interval and grabs the best one by the quality profile.
+func schedule(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("schedule", flag.ExitOnError)
+	once := flags.Bool("once", false, "check wanted movies once and exit, e.g. when started by cron")
+	flags.Parse(args)
+
+	p, err := newProcessor(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	// nobody is there to pick files
+	p.auto = true
+
+	store, err := OpenWantedStore(wantedStorePath())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	interval := p.config.Wanted.Interval.Or(defaultScheduleInterval)
+	for {
+		err = p.checkWanted(ctx, store)
+		if err != nil {
+			exitOnError(err)
+		}
+
+		if *once {
+			return
+		}
+
+		fmt.Printf("next check at %s\n", time.Now().Add(interval).Format(time.DateTime))
+		err = sleep(ctx, interval)
+		if err != nil {
+			exitOnError(err)
+		}
+	}
+}
+
+// checkWanted continues jobs of grabbed movies, marks finished ones as done
+// and grabs releases of the rest. Failures of single movies are logged,
+// they are retried on the next check.
+func (p *processor) checkWanted(ctx context.Context, store *WantedStore) error {
+	err := store.Reload()
+	if err != nil {
+		return err
+	}
+
+	var feeds []qbitorrent.RssFeed
+	feedsLoaded := false
+
+	for _, item := range store.List() {
+		if item.Done {
+			continue
+		}
+
+		profile, err := p.config.Quality.Profile(item.Profile)
+		if err != nil {
+			log.Printf("%s: %v", item.Name, err)
+			continue
+		}
+
+		if item.Hash != "" {
+			err = p.continueWanted(ctx, store, item, profile)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !feedsLoaded {
+			feeds, err = p.tClient.GetRssFeedsContext(ctx, true)
+			if err != nil {
+				return fmt.Errorf("get rss feeds: %w", err)
+			}
+			feedsLoaded = true
+		}
+
+		hash, err := p.grabWanted(ctx, item, profile, feeds)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Printf("%s: %v", item.Name, err)
+		}
+
+		item.CheckedAt = time.Now()
+		item.Hash = hash
+		if hash != "" {
+			job, _ := p.jobs.Get(hash)
+			item.Done = job.Finished()
+		}
+
+		err = store.Save(item)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// continueWanted processes the unfinished job of the grabbed movie like
+// resume does and marks the movie done when the job is finished. The movie
+// is wanted again when its job is gone, e.g. the stalled torrent was
+// removed.
+func (p *processor) continueWanted(ctx context.Context, store *WantedStore, item Wanted, profile QualityProfile) error {
+	job, ok, err := p.currentJob(item.Hash)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !ok:
+		fmt.Printf("%s has no job, it is wanted again\n", item.Name)
+		item.Hash = ""
+	case !job.Finished():
+		if p.config.OnComplete.Enabled {
+			// on-complete processes the torrent when it is downloaded, only
+			// jobs it failed are continued here
+			torrent, err := p.tClient.GetTorrentContext(ctx, job.Hash)
+			if err != nil {
+				log.Printf("%s: %v", item.Name, err)
+				return nil
+			}
+			if flatFloat(torrent.Progress) < 1 {
+				return nil
+			}
+		}
+
+		fmt.Printf("continuing %s (%s), stage: %s\n", job.Name, job.Hash, job.Stage)
+
+		err := p.withProfile(profile).process(ctx, job, nil)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Printf("%s: %v", item.Name, err)
+			return nil
+		}
+
+		// a job waiting for the download to be moved to the library is
+		// not finished yet
+		job, _ = p.jobs.Get(job.Hash)
+		item.Done = job.Finished()
+	default:
+		item.Done = true
+	}
+
+	if item.Done {
+		fmt.Printf("%s is done\n", item.Name)
+	}
+
+	return store.Save(item)
+}
+
+// candidate is a release of a wanted movie found in rss or by search.
+type candidate struct {
+	Name   string
+	Source string
+	Score  Score
+}
+
+// grabWanted adds and processes the best release of the movie by the
+// profile. It returns the hash of the added torrent or empty string when
+// nothing suitable is found.
+func (p *processor) grabWanted(ctx context.Context, item Wanted, profile QualityProfile,
+	feeds []qbitorrent.RssFeed) (string, error) {
+	fmt.Printf("looking for %s\n", item.Name)
+
+	movie, err := p.kClient.GetByIdContext(ctx, item.KinopoiskID)
+	if err != nil {
+		return "", err
+	}
+
+	candidates := rssCandidates(*movie, feeds, profile)
+
+	results, err := p.search(ctx, searchQueries(*movie))
+	switch {
+	case errors.Is(err, errNoPlugins):
+		// rss only
+	case err != nil:
+		return "", err
+	}
+
+	for _, result := range rankResults(results, profile, movie.MovieLength) {
+		candidates = append(candidates, candidate{
+			Name:   flat(result.FileName),
+			Source: flat(result.FileUrl),
+			Score:  result.Score,
+		})
+	}
+
+	if len(candidates) == 0 {
+		fmt.Println("nothing suitable found")
+		return "", nil
+	}
+
+	best := slices.MinFunc(candidates, func(a, b candidate) int { return compareScores(a.Score, b.Score) })
+	fmt.Printf("grabbing %s, score %s\n", best.Name, best.Score)
+
+	job, err := p.withProfile(profile).addAndProcess(ctx, movie, best.Source)
+	return job.Hash, err
+}
+
+// rssCandidates returns articles with one of the movie titles and the year
+// in the title, which are not rejected by the profile. Sizes and seeders of
+// articles are unknown.
+func rssCandidates(movie kinopoisk.Movie, feeds []qbitorrent.RssFeed, profile QualityProfile) []candidate {
+	titles := movieNames(movie)
+
+	var candidates []candidate
+	for _, feed := range feeds {
+		for _, article := range feed.Articles {
+			words := nameWords(article.Title)
+			if !slices.ContainsFunc(titles, func(title string) bool { return containsPhrase(words, title) }) {
+				continue
+			}
+			if movie.Year != 0 && !slices.Contains(words, fmt.Sprint(movie.Year)) {
+				continue
+			}
+
+			source := article.TorrentUrl
+			if source == "" {
+				source = article.Link
+			}
+
+			score := profile.Score(Release{Name: article.Title, Seeders: -1}, movie.MovieLength)
+			if source == "" || score.Rejected {
+				continue
+			}
+
+			candidates = append(candidates, candidate{Name: article.Title, Source: source, Score: score})
+		}
+	}
+
+	return candidates
+}
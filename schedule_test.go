@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shadream/kftm/qbitorrent"
+	"github.com/shadream/kftm/qbitorrent/qbittest"
+)
+
+func openTestWantedStore(t *testing.T, items ...Wanted) *WantedStore {
+	t.Helper()
+
+	store, err := OpenWantedStore(filepath.Join(t.TempDir(), "wanted.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		err = store.Save(item)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return store
+}
+
+func TestCheckWantedContinuesUnfinishedJob(t *testing.T) {
+	p, srv := newTestProcessor(t, func(config *Config) {
+		config.Quality = QualityConfig{
+			Default:  "hd",
+			Profiles: map[string]QualityProfile{"hd": {Resolutions: []string{"1080p"}}},
+		}
+	})
+	p.profile = QualityProfile{Resolutions: []string{"720p"}}
+
+	// grabbed by an earlier check which was interrupted after adding
+	srv.AddTorrent(qbittest.Torrent{
+		Hash:     testMagnet,
+		Name:     "Shawshank.1994.1080p.mkv",
+		Category: "movies",
+		SavePath: p.config.Qbitorrent.SavePath,
+		AutoTMM:  true,
+		State:    qbitorrent.TorrentInfoStateUploading,
+		Progress: 1,
+		Files:    []qbittest.File{{Name: "Shawshank.1994.1080p.mkv", Size: 8 << 30, Priority: 1}},
+	})
+	os.MkdirAll(p.config.Qbitorrent.RealSavePath, 0o755)
+	os.WriteFile(filepath.Join(p.config.Qbitorrent.RealSavePath, "Shawshank.1994.1080p.mkv"), nil, 0o644)
+
+	err := p.jobs.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Category: "movies", Stage: StageAdded})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := openTestWantedStore(t, Wanted{KinopoiskID: 326, Name: testMovieName, AddedAt: time.Now(), Hash: testMagnet})
+
+	err = p.checkWanted(context.Background(), store)
+	if err != nil {
+		t.Fatalf("check wanted: %v", err)
+	}
+
+	assertFinished(t, p, srv, testMagnet, filepath.Join(p.config.Qbitorrent.RealSavePath, testMovieName))
+
+	item, _ := store.Get(326)
+	if !item.Done {
+		t.Errorf("wanted %+v, want done", item)
+	}
+
+	if len(p.profile.Resolutions) != 1 || p.profile.Resolutions[0] != "720p" {
+		t.Errorf("processor profile is replaced by %+v", p.profile)
+	}
+}
+
+func TestCheckWantedWithoutJobWantsAgain(t *testing.T) {
+	p, _ := newTestProcessor(t, nil)
+	store := openTestWantedStore(t, Wanted{KinopoiskID: 326, Name: testMovieName, AddedAt: time.Now(), Hash: testMagnet})
+
+	err := p.checkWanted(context.Background(), store)
+	if err != nil {
+		t.Fatalf("check wanted: %v", err)
+	}
+
+	item, _ := store.Get(326)
+	if item.Hash != "" || item.Done {
+		t.Errorf("wanted %+v, want it wanted again", item)
+	}
+}
+
+func TestCheckWantedPicksUpMoviesWantedLater(t *testing.T) {
+	p, _ := newTestProcessor(t, nil)
+	store := openTestWantedStore(t)
+
+	err := p.jobs.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Stage: StageFinished})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// "kftm want" runs while schedule sleeps
+	user, err := OpenWantedStore(store.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = user.Save(Wanted{KinopoiskID: 326, Name: testMovieName, AddedAt: time.Now(), Hash: testMagnet})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.checkWanted(context.Background(), store)
+	if err != nil {
+		t.Fatalf("check wanted: %v", err)
+	}
+
+	item, _ := store.Get(326)
+	if !item.Done {
+		t.Errorf("wanted %+v, want done", item)
+	}
+}
+
+func TestCheckWantedSeesJobsFinishedElsewhere(t *testing.T) {
+	p, _ := newTestProcessor(t, nil)
+
+	err := p.jobs.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Category: "movies", Stage: StageRenamed})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// on-complete finishes the job while schedule runs, the torrent is
+	// not in qbitorrent anymore, so processing it again would fail
+	onComplete, err := OpenJobStore(p.jobs.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = onComplete.Save(Job{Hash: testMagnet, KinopoiskID: 326, Name: testMovieName, Category: "movies", Stage: StageFinished})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := openTestWantedStore(t, Wanted{KinopoiskID: 326, Name: testMovieName, AddedAt: time.Now(), Hash: testMagnet})
+
+	err = p.checkWanted(context.Background(), store)
+	if err != nil {
+		t.Fatalf("check wanted: %v", err)
+	}
+
+	item, _ := store.Get(326)
+	if !item.Done {
+		t.Errorf("wanted %+v, want done", item)
+	}
+}
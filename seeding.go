@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// SeedingPolicy sets share limits of processed torrents. A policy matches by
+// category and tracker, empty ones match any torrent.
+type SeedingPolicy struct {
+	// Current category of the torrent or the one it was added to, torrents
+	// moved to the library match policies of their staging category too
+	Category string `json:"category"`
+	// Part of the tracker url, e.g. "rutracker"
+	Tracker string `json:"tracker"`
+	// Ratio to stop seeding at, -1 is no limit, the global limit when unset
+	RatioLimit *float64 `json:"ratio_limit"`
+	// Seeding time to stop at, e.g. "720h", negative is no limit, the global
+	// limit when unset
+	SeedingTimeLimit *Duration `json:"seeding_time_limit"`
+	// Remove torrents reached the limits with the cleanup command, files are
+	// kept
+	Remove bool `json:"remove"`
+}
+
+func (s SeedingPolicy) matches(torrent qbitorrent.TorrentInfo, stagingCategory string) bool {
+	return (s.Category == "" || s.Category == flat(torrent.Category) || s.Category == stagingCategory) &&
+		(s.Tracker == "" || strings.Contains(flat(torrent.Tracker), s.Tracker))
+}
+
+// seedingPolicy returns the first policy matching the torrent, the job of
+// the torrent tells the category it was added to.
+func (p *processor) seedingPolicy(torrent qbitorrent.TorrentInfo) (SeedingPolicy, bool) {
+	job, _ := p.jobs.Get(flat(torrent.Hash))
+
+	return TakeOne(p.config.Seeding, func(policy SeedingPolicy) bool { return policy.matches(torrent, job.Category) })
+}
+
+// applySeedingPolicy sets share limits of the policy matching the torrent of
+// the job, torrents without a policy keep their limits.
+func (p *processor) applySeedingPolicy(ctx context.Context, job Job) error {
+	torrent, err := p.tClient.GetTorrentContext(ctx, job.Hash)
+	if err != nil {
+		return err
+	}
+
+	policy, ok := p.seedingPolicy(*torrent)
+	if !ok {
+		return nil
+	}
+
+	// -2 keeps the global limit
+	ratio, minutes := float32(-2), -2
+	if policy.RatioLimit != nil {
+		ratio = float32(*policy.RatioLimit)
+	}
+	if policy.SeedingTimeLimit != nil {
+		minutes = int(max(time.Duration(*policy.SeedingTimeLimit).Minutes(), -1))
+	}
+
+	err = p.tClient.SetShareLimitsContext(ctx, qbitorrent.TorrentsSetShareLimitsPostFormdataBody{
+		Hashes:           job.Hash,
+		RatioLimit:       &ratio,
+		SeedingTimeLimit: &minutes,
+	})
+	if err != nil {
+		return fmt.Errorf("set share limits: %w", err)
+	}
+
+	return nil
+}
+
+// limitsReached reports whether the completed torrent reached the ratio or
+// seeding time limit of the policy or, when the policy has none, the limits
+// qbitorrent applies to the torrent.
+func limitsReached(torrent qbitorrent.TorrentInfo, policy SeedingPolicy) bool {
+	if flatFloat(torrent.Progress) < 1 {
+		return false
+	}
+
+	// negative is no limit
+	ratioLimit := float64(-1)
+	switch {
+	case policy.RatioLimit != nil:
+		ratioLimit = *policy.RatioLimit
+	case torrent.MaxRatio != nil:
+		ratioLimit = float64(*torrent.MaxRatio)
+	}
+
+	timeLimit := time.Duration(-1)
+	switch {
+	case policy.SeedingTimeLimit != nil:
+		timeLimit = time.Duration(*policy.SeedingTimeLimit)
+	case torrent.MaxSeedingTime != nil:
+		timeLimit = time.Duration(*torrent.MaxSeedingTime) * time.Second
+	}
+
+	seedingTime := time.Duration(flatInt(torrent.SeedingTime)) * time.Second
+
+	return ratioLimit >= 0 && float64(flatFloat(torrent.Ratio)) >= ratioLimit ||
+		timeLimit >= 0 && seedingTime >= timeLimit
+}
+
+// cleanup reports torrents processed by kftm which reached limits of their
+// seeding policies and removes them from qbitorrent, files stay in the
+// library.
+func cleanup(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("cleanup", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "only report torrents to remove")
+	flags.Parse(args)
+
+	p, err := newProcessor(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	torrents, err := p.tClient.GetTorrentListContext(ctx, qbitorrent.TorrentsInfoPostFormdataBody{})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	removed := p.torrentsToRemove(torrents)
+	if len(removed) == 0 || *dryRun {
+		fmt.Printf("%d torrents to remove\n", len(removed))
+		return
+	}
+
+	err = p.tClient.DeleteTorrentsContext(ctx, removed, false)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("removed %d torrents\n", len(removed))
+}
+
+// torrentsToRemove reports torrents kftm has processed which reached limits
+// of their seeding policies and returns hashes of the ones to remove.
+// Torrents still being processed are skipped, removing them would leave
+// their jobs unfinished.
+func (p *processor) torrentsToRemove(torrents []qbitorrent.TorrentInfo) []string {
+	var removed []string
+	for _, torrent := range torrents {
+		job, _ := p.jobs.Get(flat(torrent.Hash))
+		if !job.Finished() && !slices.Contains(qbitorrent.TagList(torrent), tagMetadata) {
+			continue
+		}
+
+		policy, ok := p.seedingPolicy(torrent)
+		if !ok || !limitsReached(torrent, policy) {
+			continue
+		}
+
+		action := "keeping"
+		if policy.Remove {
+			action = "removing"
+			removed = append(removed, flat(torrent.Hash))
+		}
+
+		fmt.Printf("%s %s: ratio %.2f, seeding %s\n", action, flat(torrent.Name), flatFloat(torrent.Ratio),
+			time.Duration(flatInt(torrent.SeedingTime))*time.Second)
+	}
+
+	return removed
+}
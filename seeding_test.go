@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+func TestTorrentsToRemoveSkipsUnfinishedJobs(t *testing.T) {
+	p, _ := newTestProcessor(t, func(config *Config) {
+		config.Seeding = []SeedingPolicy{{RatioLimit: makePointer(2.0), Remove: true}}
+	})
+
+	seeded := func(hash, tags string) qbitorrent.TorrentInfo {
+		return qbitorrent.TorrentInfo{
+			Hash:     &hash,
+			Name:     &hash,
+			Tags:     &tags,
+			Progress: makePointer(float32(1)),
+			Ratio:    makePointer(float32(3)),
+		}
+	}
+	hash := func(i int) string { return fmt.Sprintf("%040x", i) }
+
+	for i, stage := range map[int]JobStage{1: StageRenamed, 2: StageFinished} {
+		err := p.jobs.Save(Job{Hash: hash(i), KinopoiskID: 326, Name: testMovieName, Stage: stage})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	torrents := []qbitorrent.TorrentInfo{
+		// waits for the move to the library, on-complete finishes it later
+		seeded(hash(1), "kftm:renamed, kp:326"),
+		// finished, the user has removed the tags
+		seeded(hash(2), ""),
+		// finished by another kftm, its jobs file is not here
+		seeded(hash(3), "kftm:metadata, kp:326"),
+		// added by an rss rule and not adopted yet
+		seeded(hash(4), "kp:326"),
+		// not added by kftm
+		seeded(hash(5), ""),
+	}
+
+	removed := p.torrentsToRemove(torrents)
+	if want := []string{hash(2), hash(3)}; !slices.Equal(removed, want) {
+		t.Errorf("removed %v, want %v", removed, want)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+var (
+	videoExtensions = []string{
+		".mkv", ".mp4", ".avi", ".m4v", ".mov", ".wmv", ".ts", ".m2ts", ".mpg", ".mpeg", ".vob", ".webm",
+	}
+	subtitleExtensions = []string{".srt", ".ass", ".ssa", ".sub", ".idx", ".sup", ".vtt"}
+	audioExtensions    = []string{".mka", ".ac3", ".eac3", ".dts", ".aac", ".flac", ".mp3", ".m4a", ".thd", ".wav"}
+)
+
+func isVideo(name string) bool {
+	return slices.Contains(videoExtensions, strings.ToLower(path.Ext(name)))
+}
+
+// selectFiles sets priority 0 to files that are not needed with the movie
+// file: samples, other video files and subtitles or audio in other
+// languages.
+func (p *processor) selectFiles(ctx context.Context, hash string, content []qbitorrent.TorrentsFiles,
+	movie qbitorrent.TorrentsFiles) error {
+	if !p.config.Files.SkipUnwanted {
+		return nil
+	}
+
+	var skipped []int64
+	for _, file := range content {
+		if file.Index == nil || file.Name == nil {
+			continue
+		}
+
+		if *file.Name == *movie.Name || p.wantedFile(*file.Name) {
+			continue
+		}
+
+		skipped = append(skipped, *file.Index)
+	}
+
+	if len(skipped) == 0 {
+		return nil
+	}
+
+	fmt.Printf("skipping %d of %d files\n", len(skipped), len(content))
+
+	return p.tClient.SetFilePriorityContext(ctx, hash, skipped, qbitorrent.TorrentsFilesPriorityN0)
+}
+
+// wantedFile reports whether the file is subtitles or external audio in one
+// of configured languages.
+func (p *processor) wantedFile(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	if !slices.Contains(subtitleExtensions, ext) && !slices.Contains(audioExtensions, ext) {
+		return false
+	}
+
+	languages := p.config.Files.Languages
+	if len(languages) == 0 {
+		return true
+	}
+
+	words := nameWords(name)
+	for _, language := range languages {
+		if slices.Contains(words, strings.ToLower(language)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var nonLetterRegex = regexp.MustCompile(`[^\p{L}\p{N}]+`)
+
+// nameWords splits lower cased path into words, "Rus Sound/Movie.Dub.mka"
+// gives [rus sound movie dub mka].
+func nameWords(name string) []string {
+	return strings.Fields(nonLetterRegex.ReplaceAllString(strings.ToLower(name), " "))
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	storeLockTimeout = 30 * time.Second
+	// lock files of crashed processes are removed after the time, stores
+	// are written in milliseconds
+	storeLockStale = time.Minute
+)
+
+// updateStoreFile rewrites the store file under a lock, so concurrent kftm
+// processes (on-complete runs, schedule and commands of the user) do not
+// lose each other's changes. update gets current contents of the file, nil
+// when it is missing, and returns new ones.
+func updateStoreFile(path string, update func(data []byte) ([]byte, error)) error {
+	unlock, err := lockStoreFile(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read %s: %w", filepath.Base(path), err)
+	}
+
+	data, err = update(data)
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(path, data)
+}
+
+// lockStoreFile creates the lock file next to the store and waits while
+// another process holds it. Lock files work the same on every system,
+// unlike flock.
+func lockStoreFile(path string) (unlock func(), err error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(storeLockTimeout)
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("lock %s: %w", filepath.Base(path), err)
+		}
+
+		info, statErr := os.Stat(lockPath)
+		if statErr == nil && time.Since(info.ModTime()) > storeLockStale {
+			os.Remove(lockPath)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("lock %s: locked by another process, remove %s if none runs", filepath.Base(path), lockPath)
+		}
+
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// replaceFile writes a temporary file in the same folder and renames it, so
+// the file is never left half written.
+func replaceFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Chmod(0o644)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("replace %s: %w", filepath.Base(path), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestJobStoresKeepChangesOfEachOther(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+
+	// like on-complete runs of torrents finished at the same time
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			store, err := OpenJobStore(path)
+			if err == nil {
+				err = store.Save(Job{Hash: fmt.Sprintf("%040x", i), Stage: StageAdded})
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, err := OpenJobStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jobs := store.Unfinished(); len(jobs) != 10 {
+		t.Errorf("%d jobs saved, want 10", len(jobs))
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("temporary or lock files are left: %v", entries)
+	}
+}
+
+func TestWantedStoreReloadsBeforeSaving(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wanted.json")
+
+	schedule, err := OpenWantedStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user, err := OpenWantedStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = user.Save(Wanted{KinopoiskID: 326, Name: "Побег из Шоушенка"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = schedule.Save(Wanted{KinopoiskID: 435, Name: "Зеленая миля"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := schedule.Get(326); !ok {
+		t.Error("movie wanted by another process is not loaded")
+	}
+
+	reopened, err := OpenWantedStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if movies := reopened.List(); len(movies) != 2 {
+		t.Errorf("wanted %+v, want both movies", movies)
+	}
+}
+
+func TestStaleStoreLockIsRemoved(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+
+	// left by a crashed process
+	lockPath := path + ".lock"
+	err := os.WriteFile(lockPath, nil, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * storeLockStale)
+	os.Chtimes(lockPath, old, old)
+
+	store, err := OpenJobStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Save(Job{Hash: testMagnet, Stage: StageAdded})
+	if err != nil {
+		t.Fatalf("save: %v", err)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// Tags show progress of jobs in qbitorrent UI. A torrent handled by kftm has
+// one of the state tags and the kinopoisk id tag like "kp:12345".
+const (
+	tagPending  = "kftm:pending"
+	tagRenamed  = "kftm:renamed"
+	tagMoved    = "kftm:moved"
+	tagMetadata = "kftm:metadata"
+	tagError    = "kftm:error"
+
+	kinopoiskTagPrefix = "kp:"
+)
+
+var stateTags = []string{tagPending, tagRenamed, tagMoved, tagMetadata, tagError, tagStalled}
+
+// stageTag returns the state tag of a job completed up to stage.
+func stageTag(stage JobStage) string {
+	switch stage {
+	case StageRenamed:
+		return tagRenamed
+	case StageMoved:
+		return tagMoved
+	case StageFinished:
+		return tagMetadata
+	default:
+		return tagPending
+	}
+}
+
+func kinopoiskTag(filmId int) string {
+	return kinopoiskTagPrefix + strconv.Itoa(filmId)
+}
+
+// kinopoiskIdFromTags returns the kinopoisk id stored in tags or -1.
+func kinopoiskIdFromTags(tags []string) int {
+	for _, tag := range tags {
+		id, ok := strings.CutPrefix(tag, kinopoiskTagPrefix)
+		if !ok {
+			continue
+		}
+
+		filmId, err := strconv.Atoi(id)
+		if err == nil {
+			return filmId
+		}
+	}
+
+	return -1
+}
+
+// jobTags returns tags a torrent of the job should have.
+func jobTags(job Job) []string {
+	return []string{stageTag(job.Stage), kinopoiskTag(job.KinopoiskID)}
+}
+
+// createStateTags registers state tags, so they are listed in qbitorrent
+// before any torrent has them.
+func createStateTags(ctx context.Context, tClient *qbitorrent.Client) error {
+	err := tClient.CreateTagsContext(ctx, stateTags)
+	if err != nil {
+		return fmt.Errorf("create tags: %w", err)
+	}
+
+	return nil
+}
+
+// markStage replaces state and kinopoisk tags of the torrent with the tags
+// of the job. A torrent qbitorrent does not know yet is skipped, it gets the
+// tags when it is added.
+func (p *processor) markStage(ctx context.Context, job Job) error {
+	torrent, err := p.tClient.GetTorrentContext(ctx, job.Hash)
+	if errors.Is(err, qbitorrent.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	wanted := jobTags(job)
+	stale := Filter(qbitorrent.TagList(*torrent), func(tag string) bool {
+		isState := slices.Contains(stateTags, tag) || strings.HasPrefix(tag, kinopoiskTagPrefix)
+		return isState && !slices.Contains(wanted, tag)
+	})
+
+	if len(stale) != 0 {
+		err = p.tClient.RemoveTagsContext(ctx, []string{job.Hash}, stale)
+		if err != nil {
+			return fmt.Errorf("remove tags: %w", err)
+		}
+	}
+
+	err = p.tClient.AddTagsContext(ctx, []string{job.Hash}, wanted)
+	if err != nil {
+		return fmt.Errorf("add tags: %w", err)
+	}
+
+	return nil
+}
+
+// markError tags the torrent of a failed job. Failure to tag is only logged,
+// the error of the job is reported by the caller.
+func (p *processor) markError(ctx context.Context, hash string) {
+	others := Filter(stateTags, func(tag string) bool { return tag != tagError })
+
+	err := p.tClient.RemoveTagsContext(ctx, []string{hash}, others)
+	if err == nil {
+		err = p.tClient.AddTagsContext(ctx, []string{hash}, []string{tagError})
+	}
+	if err != nil {
+		log.Printf("tag %s as failed: %v", hash, err)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Wanted is a movie the schedule command looks for until a release of it is
+// grabbed and processed.
+type Wanted struct {
+	KinopoiskID int    `json:"kinopoisk_id"`
+	Name        string `json:"name"`
+	// Quality profile to choose the release, the default one when empty
+	Profile string    `json:"profile,omitempty"`
+	AddedAt time.Time `json:"added_at"`
+	// Last time releases were looked for
+	CheckedAt time.Time `json:"checked_at,omitempty"`
+	// Hash of the grabbed torrent, the movie is not looked for anymore
+	Hash string `json:"hash,omitempty"`
+	// Set when the job of the grabbed torrent is finished
+	Done bool `json:"done"`
+}
+
+type WantedStore struct {
+	path   string
+	movies map[int]Wanted
+}
+
+// OpenWantedStore reads wanted movies from the file. Missing file is an
+// empty store.
+func OpenWantedStore(path string) (*WantedStore, error) {
+	store := &WantedStore{path: path}
+
+	err := store.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Reload reads movies again, "kftm want" may have added or removed some
+// since the store was read.
+func (s *WantedStore) Reload() error {
+	data, err := os.ReadFile(s.path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("read wanted file: %w", err)
+	}
+
+	return s.load(data)
+}
+
+// load replaces movies of the store by the ones in the file contents.
+func (s *WantedStore) load(data []byte) error {
+	var movies []Wanted
+	if data != nil {
+		err := json.Unmarshal(data, &movies)
+		if err != nil {
+			return fmt.Errorf("unmarshal wanted: %w", err)
+		}
+	}
+
+	s.movies = make(map[int]Wanted, len(movies))
+	for _, movie := range movies {
+		s.movies[movie.KinopoiskID] = movie
+	}
+
+	return nil
+}
+
+func (s *WantedStore) Get(filmId int) (Wanted, bool) {
+	movie, ok := s.movies[filmId]
+	return movie, ok
+}
+
+// List returns all wanted movies, oldest first.
+func (s *WantedStore) List() []Wanted {
+	movies := make([]Wanted, 0, len(s.movies))
+	for _, movie := range s.movies {
+		movies = append(movies, movie)
+	}
+
+	slices.SortFunc(movies, func(a, b Wanted) int { return a.AddedAt.Compare(b.AddedAt) })
+
+	return movies
+}
+
+// Save adds or updates the movie and writes the store to disk.
+func (s *WantedStore) Save(movie Wanted) error {
+	return s.update(func() { s.movies[movie.KinopoiskID] = movie })
+}
+
+// Delete removes the movie and writes the store to disk.
+func (s *WantedStore) Delete(filmId int) error {
+	return s.update(func() { delete(s.movies, filmId) })
+}
+
+// update applies the change to movies read from disk under the lock, so
+// changes other processes saved since the store was read are kept.
+func (s *WantedStore) update(change func()) error {
+	return updateStoreFile(s.path, func(data []byte) ([]byte, error) {
+		err := s.load(data)
+		if err != nil {
+			return nil, err
+		}
+
+		change()
+
+		data, err = json.MarshalIndent(s.List(), "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("marshal wanted: %w", err)
+		}
+
+		return data, nil
+	})
+}
+
+func wantedStorePath() string {
+	return filepath.Join(filepath.Dir(configPath), "wanted.json")
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// rssRuleIgnoreDays is how long a rule ignores matches after it downloaded a
+// release. The rule is removed when the torrent is adopted, this only stops
+// other feeds from adding the movie again before that.
+const rssRuleIgnoreDays = 365
+
+// rssRulePrefix starts names of rss rules created by kftm, the kinopoisk tag
+// follows it, e.g. "kftm kp:326 Побег из Шоушенка (1994)".
+const rssRulePrefix = "kftm "
+
+// qbitorrent matches rules with QRegularExpression, where \b knows only
+// latin letters, so word boundaries are spelled out
+const (
+	regexNotLetterBefore = `(?<![a-zа-яё0-9])`
+	regexNotLetterAfter  = `(?![a-zа-яё0-9])`
+)
+
+// watchlist manages rss rules which download movies when they appear in
+// feeds. Torrents added by the rules have the kinopoisk tag and are
+// processed by the resume command.
+func watchlist(ctx context.Context, args []string) {
+	usage := "usage: kftm watchlist add [-profile name] <kinopoisk url or id> | remove <kinopoisk url or id>"
+	if len(args) == 0 {
+		log.Fatal(usage)
+	}
+
+	switch args[0] {
+	case "add":
+		watchlistAdd(ctx, args[1:])
+	case "remove":
+		watchlistRemove(ctx, args[1:])
+	default:
+		log.Fatal(usage)
+	}
+}
+
+func watchlistAdd(ctx context.Context, args []string) {
+	flags := flag.NewFlagSet("watchlist add", flag.ExitOnError)
+	profileName := flags.String("profile", "", "quality profile to build the rule, the default one from config if empty")
+	flags.Parse(args)
+
+	filmId := parseKinopoiskUrl(flags.Arg(0))
+	if flags.NArg() != 1 || filmId == -1 {
+		log.Fatal("can not get kinopoisk film id")
+	}
+
+	p, err := newProcessor(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	profile, err := p.config.Quality.Profile(*profileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	movie, err := p.kClient.GetByIdContext(ctx, filmId)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	feeds := p.config.Rss.Feeds
+	if len(feeds) == 0 {
+		all, err := p.tClient.GetRssFeedsContext(ctx, false)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		feeds = Select(all, func(feed qbitorrent.RssFeed) string { return feed.Url })
+	}
+	if len(feeds) == 0 {
+		log.Fatal("no rss feeds in qbitorrent")
+	}
+
+	name := rssRuleName(*movie)
+	err = p.tClient.SetRssRuleContext(ctx, name, movieRssRule(*movie, profile, feeds,
+		p.config.Qbitorrent.CategoryForType(movie.Type).Category))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("rss rule %q watches %d feeds\n", name, len(feeds))
+
+	matching, err := p.tClient.GetRssMatchingArticlesContext(ctx, name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for feed, articles := range matching {
+		for _, article := range articles {
+			fmt.Printf("already matches in %s: %s\n", feed, article)
+		}
+	}
+}
+
+func watchlistRemove(ctx context.Context, args []string) {
+	filmId := -1
+	if len(args) == 1 {
+		filmId = parseKinopoiskUrl(args[0])
+	}
+	if filmId == -1 {
+		log.Fatal("can not get kinopoisk film id")
+	}
+
+	config, err := readConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tClient, err := createQbitorrentClient(ctx, config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	removed, err := removeRssRules(ctx, tClient, filmId)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if removed == 0 {
+		fmt.Println("no rss rule for the movie")
+	}
+}
+
+// removeRssRules removes rules kftm created for the movie and returns how
+// many there were.
+func removeRssRules(ctx context.Context, tClient *qbitorrent.Client, filmId int) (int, error) {
+	rules, err := tClient.GetRssRulesContext(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get rss rules: %w", err)
+	}
+
+	prefix := rssRulePrefix + kinopoiskTag(filmId) + " "
+	removed := 0
+	for name := range rules {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		err = tClient.RemoveRssRuleContext(ctx, name)
+		if err != nil {
+			return removed, fmt.Errorf("remove rss rule %q: %w", name, err)
+		}
+
+		fmt.Printf("removed rss rule %q\n", name)
+		removed++
+	}
+
+	return removed, nil
+}
+
+func rssRuleName(movie kinopoisk.Movie) string {
+	return rssRulePrefix + kinopoiskTag(int(movie.ID)) + " " + movieName(movie)
+}
+
+// movieRssRule builds a regex rule matching releases of the movie by any of
+// its titles and the year, with resolution, required and forbidden words of
+// the profile.
+func movieRssRule(movie kinopoisk.Movie, profile QualityProfile, feeds []string, category string) qbitorrent.RssRule {
+	mustContain := "(?=.*(?:" + strings.Join(Select(movieNames(movie), titlePattern), "|") + "))"
+	if movie.Year != 0 {
+		mustContain += "(?=.*" + wordPattern(fmt.Sprint(movie.Year)) + ")"
+	}
+
+	if len(profile.Resolutions) != 0 {
+		resolutions := slices.Clone(profile.Resolutions)
+		if slices.Contains(resolutions, "2160p") {
+			resolutions = append(resolutions, "4k", "uhd")
+		}
+		mustContain += "(?=.*(?:" + strings.Join(Select(resolutions, wordPattern), "|") + "))"
+	}
+
+	for _, pattern := range titlePatterns(profile.Required) {
+		mustContain += "(?=.*" + pattern + ")"
+	}
+
+	mustNotContain := strings.Join(titlePatterns(profile.Forbidden), "|")
+
+	return qbitorrent.RssRule{
+		RssRuleDef: qbitorrent.RssRuleDef{
+			Enabled:          makePointer(true),
+			UseRegex:         makePointer(true),
+			MustContain:      &mustContain,
+			MustNotContain:   &mustNotContain,
+			AffectedFeeds:    &feeds,
+			AssignedCategory: &category,
+			// one release is enough: other matches are ignored until the
+			// torrent is adopted, then the rule is removed
+			IgnoreDays: makePointer(float32(rssRuleIgnoreDays)),
+		},
+		TorrentParams: &qbitorrent.RssTorrentParams{
+			Category: category,
+			Tags:     []string{kinopoiskTag(int(movie.ID))},
+		},
+	}
+}
+
+// titlePattern matches the words of title separated by anything short, so
+// "Star Wars" matches "Star.Wars" and "Star - Wars".
+func titlePattern(title string) string {
+	words := Select(nameWords(title), regexp.QuoteMeta)
+	return regexNotLetterBefore + strings.Join(words, ".{1,3}") + regexNotLetterAfter
+}
+
+// titlePatterns returns patterns of the titles which have words. A pattern
+// of a title without them has only lookarounds and matches every release.
+func titlePatterns(titles []string) []string {
+	var patterns []string
+	for _, title := range titles {
+		if len(nameWords(title)) != 0 {
+			patterns = append(patterns, titlePattern(title))
+		}
+	}
+
+	return patterns
+}
+
+func wordPattern(word string) string {
+	return regexNotLetterBefore + regexp.QuoteMeta(strings.ToLower(word)) + regexNotLetterAfter
+}
+
+// adoptTagged creates jobs for torrents with the kinopoisk tag that kftm did
+// not add itself, e.g. by rss rules of the watchlist.
+func (p *processor) adoptTagged(ctx context.Context) error {
+	torrents, err := p.tClient.GetTorrentListContext(ctx, qbitorrent.TorrentsInfoPostFormdataBody{})
+	if err != nil {
+		return fmt.Errorf("get torrents: %w", err)
+	}
+
+	for _, torrent := range torrents {
+		if _, ok := p.jobs.Get(flat(torrent.Hash)); ok {
+			continue
+		}
+
+		job, ok, err := p.adoptTorrent(ctx, torrent)
+		if err != nil {
+			return err
+		}
+		if ok {
+			fmt.Printf("found %s (%s) added by kinopoisk tag\n", job.Name, job.Hash)
+		}
+	}
+
+	return nil
+}
+
+// adoptTorrent creates and saves a job for the torrent with the kinopoisk
+// tag and without state tags and removes rss rules of the movie. It reports
+// false for other torrents.
+func (p *processor) adoptTorrent(ctx context.Context, torrent qbitorrent.TorrentInfo) (Job, bool, error) {
+	tags := qbitorrent.TagList(torrent)
+	filmId := kinopoiskIdFromTags(tags)
+	if filmId == -1 || slices.ContainsFunc(tags, func(tag string) bool { return slices.Contains(stateTags, tag) }) {
+		return Job{}, false, nil
+	}
+
+	movie, err := p.kClient.GetByIdContext(ctx, filmId)
+	if err != nil {
+		return Job{}, false, err
+	}
+
+	// the movie is found, rules of the watchlist must not download it again
+	_, err = removeRssRules(ctx, p.tClient, filmId)
+	if err != nil {
+		return Job{}, false, err
+	}
+
+	job := Job{
+		Hash:        flat(torrent.Hash),
+		KinopoiskID: filmId,
+		Name:        movieName(*movie),
+		Category:    flat(torrent.Category),
+		Stage:       StageAdded,
+	}
+
+	err = p.jobs.Save(job)
+	if err != nil {
+		return Job{}, false, err
+	}
+
+	return job, true, nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/shadream/kftm/kinopoisk"
+	"github.com/shadream/kftm/qbitorrent"
+)
+
+// ruleMatches checks the title like qbitorrent checks articles against a
+// regex rule, case insensitive. Go regexp has no lookarounds, so every
+// lookahead of mustContain is matched separately and the word boundaries
+// are replaced with consuming groups.
+func ruleMatches(t *testing.T, rule qbitorrent.RssRule, title string) bool {
+	t.Helper()
+
+	match := func(pattern string) bool {
+		pattern = strings.ReplaceAll(pattern, regexNotLetterBefore, `(?:^|[^a-zа-яё0-9])`)
+		pattern = strings.ReplaceAll(pattern, regexNotLetterAfter, `(?:$|[^a-zа-яё0-9])`)
+
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			t.Fatalf("rule pattern %q: %v", pattern, err)
+		}
+
+		return re.MatchString(title)
+	}
+
+	mustContain := *rule.MustContain
+	for mustContain != "" {
+		if !strings.HasPrefix(mustContain, "(?=.*") {
+			t.Fatalf("mustContain %q is not a list of lookaheads", mustContain)
+		}
+
+		// the lookahead ends at the parenthesis closing it, words are
+		// quoted and have no parentheses
+		depth, end := 0, 0
+		for end = range len(mustContain) {
+			if mustContain[end] == '(' && (end == 0 || mustContain[end-1] != '\\') {
+				depth++
+			}
+			if mustContain[end] == ')' && mustContain[end-1] != '\\' {
+				depth--
+			}
+			if depth == 0 {
+				break
+			}
+		}
+
+		if !match(mustContain[len("(?=.*"):end]) {
+			return false
+		}
+		mustContain = mustContain[end+1:]
+	}
+
+	return *rule.MustNotContain == "" || !match(*rule.MustNotContain)
+}
+
+func TestMovieRssRuleMatchesReleases(t *testing.T) {
+	movie := kinopoisk.Movie{
+		ID:              476,
+		Name:            "Звёздные войны: Эпизод 4 – Новая надежда",
+		AlternativeName: "Star Wars",
+		Year:            1977,
+		// names without words must not match every release
+		Names: []kinopoisk.Name{{Name: "Star Wars"}, {Name: "***"}},
+	}
+	profile := QualityProfile{
+		Resolutions: []string{"2160p", "1080p"},
+		Forbidden:   []string{"CAM", "Чистый звук"},
+	}
+
+	rule := movieRssRule(movie, profile, nil, "movies")
+
+	tests := []struct {
+		title string
+		want  bool
+	}{
+		{"Star.Wars.1977.1080p.BluRay.x264", true},
+		{"Star Wars - Episode IV (1977) 2160p", true},
+		{"Звёздные войны: Эпизод 4 – Новая надежда (1977) BDRip 1080p", true},
+		{"ЗВЁЗДНЫЕ ВОЙНЫ: ЭПИЗОД 4 – НОВАЯ НАДЕЖДА 1977 1080p", true},
+		{"Star.Wars.1977.4K.HDR", true},
+		{"Star.Wars.1977.UHD.BDRemux", true},
+		{"Star.Wars.1977.720p", false},
+		{"Star.Wars.1977.1080p.CAM", false},
+		{"Звёздные войны 1977 1080p чистый звук", false},
+		// forbidden words match whole words only
+		{"Star.Wars.1977.1080p.CAMRip", true},
+		{"Star.Wars.Rebels.2014.1080p", false},
+		{"Annie Hall (1977) 1080p", false},
+		{"Superstar.Warsaw.1977.1080p", false},
+	}
+
+	for _, test := range tests {
+		if got := ruleMatches(t, rule, test.title); got != test.want {
+			t.Errorf("%q matches %v, want %v", test.title, got, test.want)
+		}
+	}
+}